@@ -0,0 +1,67 @@
+package changelog
+
+import "strings"
+
+// anonymizedCommitLength is the length commit hashes are shortened to by
+// Anonymize.
+const anonymizedCommitLength = 4
+
+// Anonymize returns a deep copy of the changelog that is safe to share
+// publicly when entries reference internal trackers or private authors.
+// In every entry, Author and ExternalURL are cleared, Issue and PR are
+// cleared unless they are plain numbers (optionally prefixed with "#"), and
+// Commit is shortened to 4 characters. Release commits are shortened the
+// same way. Maintainers, Bots, and Repository are cleared, as are release
+// compare URLs, which embed the repository. Every built-in category is
+// anonymized, regardless of the contents of DefaultRegistry.
+func (c *Changelog) Anonymize() *Changelog {
+	out := c.Clone()
+	out.Maintainers = nil
+	out.Bots = nil
+	out.Repository = ""
+
+	anonymizeRelease := func(r *Release) {
+		r.CompareURL = ""
+		r.Commit = shortenCommit(r.Commit)
+		for _, field := range r.entryFields() {
+			for i := range *field {
+				(*field)[i].anonymize()
+			}
+		}
+	}
+	if out.Unreleased != nil {
+		anonymizeRelease(out.Unreleased)
+	}
+	for i := range out.Releases {
+		anonymizeRelease(&out.Releases[i])
+	}
+	return out
+}
+
+// anonymize clears or shortens the identifying fields of e for Anonymize.
+func (e *Entry) anonymize() {
+	e.Author = ""
+	e.ExternalURL = ""
+	if !isNumericRef(e.Issue) {
+		e.Issue = ""
+	}
+	if !isNumericRef(e.PR) {
+		e.PR = ""
+	}
+	e.Commit = shortenCommit(e.Commit)
+}
+
+// shortenCommit truncates a commit hash to anonymizedCommitLength.
+func shortenCommit(commit string) string {
+	if len(commit) > anonymizedCommitLength {
+		return commit[:anonymizedCommitLength]
+	}
+	return commit
+}
+
+// isNumericRef reports whether ref is a plain issue or PR number such as
+// "123" or "#123".
+func isNumericRef(ref string) bool {
+	num := strings.TrimPrefix(ref, "#")
+	return num != "" && strings.Trim(num, "0123456789") == ""
+}
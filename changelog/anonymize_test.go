@@ -0,0 +1,59 @@
+package changelog
+
+import "testing"
+
+func TestChangelogAnonymize(t *testing.T) {
+	cl := New("test")
+	cl.Repository = "https://github.com/acme/private"
+	cl.Maintainers = []string{"alice"}
+	cl.Bots = []string{"acme-bot"}
+	cl.Unreleased = &Release{Added: []Entry{{Description: "WIP", Author: "bob", Issue: "ENG-7"}}}
+	cl.AddRelease(Release{
+		Version:    "1.0.0",
+		Date:       "2026-01-01",
+		Commit:     "9f8e7d6c5b",
+		CompareURL: "https://github.com/acme/private/compare/v0.9.0...v1.0.0",
+		Fixed: []Entry{
+			{Description: "Fix", Author: "@carol", Issue: "#42", PR: "https://github.com/acme/private/pull/43", Commit: "abc1234def", ExternalURL: "https://acme.atlassian.net/browse/ENG-1"},
+			{Description: "Fix 2", Issue: "PROJ-123", PR: "44", Commit: "ab"},
+		},
+	})
+
+	anon := cl.Anonymize()
+
+	if anon.Repository != "" || anon.Maintainers != nil || anon.Bots != nil {
+		t.Errorf("expected repository, maintainers and bots cleared, got %q %v %v", anon.Repository, anon.Maintainers, anon.Bots)
+	}
+	if anon.Releases[0].CompareURL != "" {
+		t.Errorf("expected compare URL cleared, got %q", anon.Releases[0].CompareURL)
+	}
+	if anon.Releases[0].Commit != "9f8e" {
+		t.Errorf("expected release commit shortened, got %q", anon.Releases[0].Commit)
+	}
+
+	want := []Entry{
+		{Description: "Fix", Issue: "#42", Commit: "abc1"},
+		{Description: "Fix 2", PR: "44", Commit: "ab"},
+	}
+	for i, e := range anon.Releases[0].Fixed {
+		if e.Description != want[i].Description || e.Author != "" || e.ExternalURL != "" ||
+			e.Issue != want[i].Issue || e.PR != want[i].PR || e.Commit != want[i].Commit {
+			t.Errorf("entry %d: expected %+v, got %+v", i, want[i], e)
+		}
+	}
+	if u := anon.Unreleased.Added[0]; u.Author != "" || u.Issue != "" {
+		t.Errorf("expected unreleased entry anonymized, got %+v", u)
+	}
+
+	// Every built-in category is anonymized
+	r := Release{Contributors: []Entry{{Description: "Thanks", Author: "secret"}}}
+	cl2 := &Changelog{Releases: []Release{r}}
+	if a := cl2.Anonymize().Releases[0].Contributors[0].Author; a != "" {
+		t.Errorf("expected Contributors author cleared, got %q", a)
+	}
+
+	// The receiver is unchanged
+	if cl.Repository == "" || cl.Releases[0].Fixed[0].Author != "@carol" || cl.Releases[0].Fixed[0].Commit != "abc1234def" || cl.Unreleased.Added[0].Author != "bob" {
+		t.Error("expected Anonymize not to modify the receiver")
+	}
+}
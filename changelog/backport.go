@@ -0,0 +1,47 @@
+package changelog
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Backport errors.
+var (
+	ErrUnknownCategory   = errors.New("unknown category")
+	ErrBackportDirection = errors.New("backport target must be older than source version")
+)
+
+// Backport copies an entry from fromVersion into the given category of toVersion.
+// The entry is prepended so backported changes appear first in the category.
+// toVersion must be semantically older than fromVersion. If toVersion is
+// "unreleased", the entry is added to the Unreleased section instead.
+func (c *Changelog) Backport(entry Entry, category, fromVersion, toVersion string) error {
+	if entry.Description == "" {
+		return ErrEmptyDescription
+	}
+
+	if c.FindRelease(fromVersion) == nil {
+		return fmt.Errorf("%w: %s", ErrVersionNotFound, fromVersion)
+	}
+
+	var target *Release
+	if toVersion == "unreleased" {
+		target = c.EnsureUnreleased()
+	} else {
+		target = c.FindRelease(toVersion)
+		if target == nil {
+			return fmt.Errorf("%w: %s", ErrVersionNotFound, toVersion)
+		}
+		if CompareSemVer(toVersion, fromVersion) >= 0 {
+			return fmt.Errorf("%w: %s is not older than %s", ErrBackportDirection, toVersion, fromVersion)
+		}
+	}
+
+	field := target.entriesField(category)
+	if field == nil {
+		return fmt.Errorf("%w: %q", ErrUnknownCategory, category)
+	}
+
+	*field = append([]Entry{entry.Clone()}, *field...)
+	return nil
+}
@@ -0,0 +1,87 @@
+package changelog
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBackport(t *testing.T) {
+	cl := &Changelog{
+		IRVersion: IRVersion,
+		Project:   "test",
+		Releases: []Release{
+			{Version: "1.1.0", Date: "2026-02-01", Fixed: []Entry{{Description: "Fix crash on startup"}}},
+			{Version: "1.0.0", Date: "2026-01-01", Fixed: []Entry{{Description: "Existing fix"}}},
+		},
+	}
+	entry := cl.Releases[0].Fixed[0]
+
+	if err := cl.Backport(entry, CategoryFixed, "1.1.0", "1.0.0"); err != nil {
+		t.Fatalf("Backport failed: %v", err)
+	}
+
+	target := cl.FindRelease("1.0.0")
+	if len(target.Fixed) != 2 {
+		t.Fatalf("expected 2 fixed entries, got %d", len(target.Fixed))
+	}
+	if target.Fixed[0].Description != "Fix crash on startup" {
+		t.Errorf("expected backported entry first, got %q", target.Fixed[0].Description)
+	}
+
+	// Mutating the backported entry must not affect the source
+	target.Fixed[0].Description = "changed"
+	if cl.FindRelease("1.1.0").Fixed[0].Description != "Fix crash on startup" {
+		t.Error("expected source entry to be unchanged")
+	}
+}
+
+func TestBackport_Unreleased(t *testing.T) {
+	cl := &Changelog{
+		IRVersion: IRVersion,
+		Project:   "test",
+		Releases: []Release{
+			{Version: "1.1.0", Date: "2026-02-01", Fixed: []Entry{{Description: "Fix crash on startup"}}},
+			{Version: "1.0.0", Date: "2026-01-01", Fixed: []Entry{{Description: "Existing fix"}}},
+		},
+	}
+	entry := NewEntry("Fix crash on startup")
+
+	if err := cl.Backport(entry, CategoryFixed, "1.1.0", "unreleased"); err != nil {
+		t.Fatalf("Backport failed: %v", err)
+	}
+	if cl.Unreleased == nil || len(cl.Unreleased.Fixed) != 1 {
+		t.Fatal("expected entry in unreleased section")
+	}
+}
+
+func TestBackport_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		entry       Entry
+		category    string
+		fromVersion string
+		toVersion   string
+		wantErr     error
+	}{
+		{"from version not found", NewEntry("fix"), CategoryFixed, "9.9.9", "1.0.0", ErrVersionNotFound},
+		{"to version not found", NewEntry("fix"), CategoryFixed, "1.1.0", "0.9.0", ErrVersionNotFound},
+		{"older to newer", NewEntry("fix"), CategoryFixed, "1.0.0", "1.1.0", ErrBackportDirection},
+		{"same version", NewEntry("fix"), CategoryFixed, "1.1.0", "1.1.0", ErrBackportDirection},
+		{"empty description", NewEntry(""), CategoryFixed, "1.1.0", "1.0.0", ErrEmptyDescription},
+		{"unknown category", NewEntry("fix"), "Bogus", "1.1.0", "1.0.0", ErrUnknownCategory},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := &Changelog{
+				IRVersion: IRVersion,
+				Project:   "test",
+				Releases:  []Release{{Version: "1.1.0", Date: "2026-02-01"}, {Version: "1.0.0", Date: "2026-01-01"}},
+			}
+			err := cl.Backport(tt.entry, tt.category, tt.fromVersion, tt.toVersion)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
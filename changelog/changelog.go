@@ -4,6 +4,8 @@ package changelog
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"time"
 )
@@ -19,6 +21,11 @@ const (
 	VersioningNone   = "none"   // No specific versioning scheme
 )
 
+// Source constants describe how a changelog was generated.
+const (
+	SourceGitTags = "git-tags" // Generated from git tags by "schangelog init --from-tags"
+)
+
 // Commit convention constants.
 const (
 	CommitConventionConventional = "conventional" // Conventional Commits
@@ -36,6 +43,7 @@ type Changelog struct {
 	Maintainers      []string   `json:"maintainers,omitempty"`
 	Bots             []string   `json:"bots,omitempty"`
 	GeneratedAt      *time.Time `json:"generatedAt,omitempty"`
+	Source           string     `json:"source,omitempty"`
 	Unreleased       *Release   `json:"unreleased,omitempty"`
 	Releases         []Release  `json:"releases,omitempty"`
 }
@@ -99,20 +107,20 @@ func (c *Changelog) IsTeamMemberByNameAndEmail(author, email string) bool {
 		}
 	}
 
-	// Check custom bots
-	for _, b := range c.Bots {
-		if normalizeAuthor(b) == normAuthor {
-			return true
-		}
-	}
+	// Check custom and common bots
+	return c.isBot(author)
+}
 
-	// Check common bots
-	for _, b := range CommonBots {
-		if normalizeAuthor(b) == normAuthor {
-			return true
+// isBot reports whether name is one of the changelog's bots or a common bot.
+func (c *Changelog) isBot(name string) bool {
+	norm := normalizeAuthor(name)
+	for _, bots := range [][]string{c.Bots, CommonBots} {
+		for _, b := range bots {
+			if normalizeAuthor(b) == norm {
+				return true
+			}
 		}
 	}
-
 	return false
 }
 
@@ -223,12 +231,135 @@ func (c *Changelog) WriteFile(path string) error {
 	return os.WriteFile(path, data, 0600)
 }
 
+// BackupSuffix is appended to the file name by WriteFileWithBackup.
+const BackupSuffix = ".bak"
+
+// WriteFileWithBackup writes the changelog to a JSON file, first copying any
+// existing file to path + ".bak". If the write fails, the original file is
+// restored from the backup. The backup is kept after a successful write.
+func (c *Changelog) WriteFileWithBackup(path string) error {
+	data, err := c.JSON()
+	if err != nil {
+		return err
+	}
+	return writeFileWithBackup(path, data, os.WriteFile)
+}
+
+// writeFileWithBackup implements WriteFileWithBackup with a replaceable
+// write function so failures can be simulated in tests.
+func writeFileWithBackup(path string, data []byte, write func(string, []byte, os.FileMode) error) error {
+	backupPath := path + BackupSuffix
+
+	original, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		// New file: nothing to back up
+		return write(path, data, 0600)
+	case err != nil:
+		return fmt.Errorf("failed to read %s for backup: %w", path, err)
+	}
+
+	if err := os.WriteFile(backupPath, original, 0600); err != nil {
+		return fmt.Errorf("failed to write backup %s: %w", backupPath, err)
+	}
+
+	if err := write(path, data, 0600); err != nil {
+		if restoreErr := os.WriteFile(path, original, 0600); restoreErr != nil {
+			return fmt.Errorf("failed to write %s: %w (restore from %s also failed: %v)", path, err, backupPath, restoreErr)
+		}
+		return fmt.Errorf("failed to write %s, original restored: %w", path, err)
+	}
+	return nil
+}
+
 // AddRelease adds a new release to the changelog.
 // Releases are maintained in reverse chronological order.
 func (c *Changelog) AddRelease(r Release) {
 	c.Releases = append([]Release{r}, c.Releases...)
 }
 
+// ErrVersionNotFound is returned when a requested version does not exist in the changelog.
+var ErrVersionNotFound = errors.New("version not found")
+
+// FindRelease returns the release with the given version, or nil if not found.
+// The returned pointer refers to the release within c.Releases.
+func (c *Changelog) FindRelease(version string) *Release {
+	if i := c.ReleaseIndex(version); i >= 0 {
+		return &c.Releases[i]
+	}
+	return nil
+}
+
+// ReleaseIndex returns the index in c.Releases of the release with the given
+// version, or -1 if not found.
+func (c *Changelog) ReleaseIndex(version string) int {
+	for i := range c.Releases {
+		if c.Releases[i].Version == version {
+			return i
+		}
+	}
+	return -1
+}
+
+// MarkReleaseYanked marks the release with the given version as yanked. If
+// reason is non-empty, it is added to the release as a Known Issues entry.
+// Returns ErrVersionNotFound if the version does not exist.
+func (c *Changelog) MarkReleaseYanked(version, reason string) error {
+	r := c.FindRelease(version)
+	if r == nil {
+		return fmt.Errorf("%w: %s", ErrVersionNotFound, version)
+	}
+	r.Yanked = true
+	if reason != "" {
+		r.AddKnownIssues(NewEntry(reason))
+	}
+	return nil
+}
+
+// ErrNoPreviousRelease is returned by PreviousRelease for the oldest release.
+var ErrNoPreviousRelease = errors.New("no previous release")
+
+// ErrNoNextRelease is returned by NextRelease for the newest release.
+var ErrNoNextRelease = errors.New("no next release")
+
+// PreviousRelease returns the release that precedes version, i.e. the next
+// element in the newest-first Releases slice. Returns ErrVersionNotFound if
+// the version does not exist, or ErrNoPreviousRelease if it is the oldest.
+func (c *Changelog) PreviousRelease(version string) (*Release, error) {
+	i, err := c.releaseIndex(version)
+	if err != nil {
+		return nil, err
+	}
+	if i == len(c.Releases)-1 {
+		return nil, fmt.Errorf("%w: %s", ErrNoPreviousRelease, version)
+	}
+	return &c.Releases[i+1], nil
+}
+
+// NextRelease returns the release that follows version, i.e. the previous
+// element in the newest-first Releases slice. Returns ErrVersionNotFound if
+// the version does not exist, or ErrNoNextRelease if it is the newest.
+func (c *Changelog) NextRelease(version string) (*Release, error) {
+	i, err := c.releaseIndex(version)
+	if err != nil {
+		return nil, err
+	}
+	if i == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoNextRelease, version)
+	}
+	return &c.Releases[i-1], nil
+}
+
+// releaseIndex returns the index of version in c.Releases, or
+// ErrVersionNotFound.
+func (c *Changelog) releaseIndex(version string) (int, error) {
+	i := c.ReleaseIndex(version)
+	if i < 0 {
+		return -1, fmt.Errorf("%w: %s", ErrVersionNotFound, version)
+	}
+	return i, nil
+}
+
 // LatestRelease returns the most recent release, or nil if none exist.
 func (c *Changelog) LatestRelease() *Release {
 	if len(c.Releases) == 0 {
@@ -237,6 +368,15 @@ func (c *Changelog) LatestRelease() *Release {
 	return &c.Releases[0]
 }
 
+// EnsureUnreleased returns the Unreleased section, creating an empty one
+// first if it does not exist.
+func (c *Changelog) EnsureUnreleased() *Release {
+	if c.Unreleased == nil {
+		c.Unreleased = &Release{}
+	}
+	return c.Unreleased
+}
+
 // PromoteUnreleased moves unreleased changes to a new release.
 func (c *Changelog) PromoteUnreleased(version, date string) error {
 	if c.Unreleased == nil {
@@ -259,7 +399,7 @@ type Summary struct {
 	UnreleasedCategories []string
 	LatestVersion        string
 	LatestDate           string
-	LatestCategories     []string
+	LatestCategories     []string // Notable categories of the latest release
 }
 
 // Summary returns a summary of the changelog's contents.
@@ -280,12 +420,10 @@ func (c *Changelog) Summary() Summary {
 
 	// Get latest release info
 	if len(c.Releases) > 0 {
-		latest := c.Releases[0]
+		latest := c.Releases[0].Summary()
 		s.LatestVersion = latest.Version
 		s.LatestDate = latest.Date
-		for _, cat := range latest.Categories() {
-			s.LatestCategories = append(s.LatestCategories, cat.Name)
-		}
+		s.LatestCategories = latest.NotableCategories
 	}
 
 	return s
@@ -1,6 +1,7 @@
 package changelog
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -83,6 +84,104 @@ func TestLatestRelease(t *testing.T) {
 	}
 }
 
+func TestFindRelease(t *testing.T) {
+	cl := New("test")
+	cl.AddRelease(NewRelease("1.0.0", "2026-01-01"))
+	cl.AddRelease(NewRelease("1.1.0", "2026-01-02"))
+
+	r := cl.FindRelease("1.0.0")
+	if r == nil {
+		t.Fatal("expected to find release 1.0.0")
+	}
+	if r.Date != "2026-01-01" {
+		t.Errorf("expected date 2026-01-01, got %s", r.Date)
+	}
+
+	if cl.FindRelease("2.0.0") != nil {
+		t.Error("expected nil for unknown version")
+	}
+
+	// AddRelease prepends, so the newest release is first
+	if i := cl.ReleaseIndex("1.0.0"); i != 1 {
+		t.Errorf("expected index 1 for 1.0.0, got %d", i)
+	}
+	if i := cl.ReleaseIndex("2.0.0"); i != -1 {
+		t.Errorf("expected -1 for unknown version, got %d", i)
+	}
+}
+
+func TestPreviousAndNextRelease(t *testing.T) {
+	cl := New("test")
+	cl.AddRelease(NewRelease("1.0.0", "2026-01-01"))
+	cl.AddRelease(NewRelease("1.1.0", "2026-01-02"))
+	cl.AddRelease(NewRelease("1.2.0", "2026-01-03"))
+
+	prev, err := cl.PreviousRelease("1.1.0")
+	if err != nil || prev.Version != "1.0.0" {
+		t.Errorf("expected previous 1.0.0, got %v, %v", prev, err)
+	}
+	next, err := cl.NextRelease("1.1.0")
+	if err != nil || next.Version != "1.2.0" {
+		t.Errorf("expected next 1.2.0, got %v, %v", next, err)
+	}
+
+	if _, err := cl.PreviousRelease("1.0.0"); !errors.Is(err, ErrNoPreviousRelease) {
+		t.Errorf("expected ErrNoPreviousRelease, got %v", err)
+	}
+	if _, err := cl.NextRelease("1.2.0"); !errors.Is(err, ErrNoNextRelease) {
+		t.Errorf("expected ErrNoNextRelease, got %v", err)
+	}
+	if _, err := cl.PreviousRelease("2.0.0"); !errors.Is(err, ErrVersionNotFound) {
+		t.Errorf("expected ErrVersionNotFound, got %v", err)
+	}
+	if _, err := cl.NextRelease("2.0.0"); !errors.Is(err, ErrVersionNotFound) {
+		t.Errorf("expected ErrVersionNotFound, got %v", err)
+	}
+}
+
+func TestMarkReleaseYanked(t *testing.T) {
+	cl := New("test")
+	cl.AddRelease(NewRelease("1.0.0", "2026-01-01"))
+	cl.AddRelease(NewRelease("1.1.0", "2026-01-02"))
+
+	if err := cl.MarkReleaseYanked("1.1.0", "Data loss when upgrading from 1.0.0"); err != nil {
+		t.Fatalf("MarkReleaseYanked failed: %v", err)
+	}
+	r := cl.FindRelease("1.1.0")
+	if !r.Yanked {
+		t.Error("expected release to be yanked")
+	}
+	if len(r.KnownIssues) != 1 || r.KnownIssues[0].Description != "Data loss when upgrading from 1.0.0" {
+		t.Errorf("expected reason as known issue, got %+v", r.KnownIssues)
+	}
+
+	// Empty reason adds no entry
+	if err := cl.MarkReleaseYanked("1.0.0", ""); err != nil {
+		t.Fatalf("MarkReleaseYanked failed: %v", err)
+	}
+	if r := cl.FindRelease("1.0.0"); !r.Yanked || len(r.KnownIssues) != 0 {
+		t.Errorf("expected yanked release without known issues, got %+v", r)
+	}
+
+	if err := cl.MarkReleaseYanked("2.0.0", "reason"); !errors.Is(err, ErrVersionNotFound) {
+		t.Errorf("expected ErrVersionNotFound, got %v", err)
+	}
+}
+
+func TestEnsureUnreleased(t *testing.T) {
+	cl := New("test")
+
+	u := cl.EnsureUnreleased()
+	if u == nil || cl.Unreleased != u {
+		t.Fatal("expected EnsureUnreleased to create the Unreleased section")
+	}
+	u.AddAdded(NewEntry("Feature"))
+
+	if again := cl.EnsureUnreleased(); again != u || len(again.Added) != 1 {
+		t.Error("expected EnsureUnreleased to return the existing section")
+	}
+}
+
 func TestPromoteUnreleased(t *testing.T) {
 	cl := New("test")
 	cl.Unreleased = &Release{
@@ -202,6 +301,74 @@ func TestWriteFile(t *testing.T) {
 	}
 }
 
+func TestWriteFileWithBackup(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "CHANGELOG.json")
+
+	// New file: no backup is created
+	cl := New("backup-test")
+	if err := cl.WriteFileWithBackup(tmpFile); err != nil {
+		t.Fatalf("WriteFileWithBackup failed: %v", err)
+	}
+	if _, err := os.Stat(tmpFile + BackupSuffix); !os.IsNotExist(err) {
+		t.Errorf("expected no backup for new file, stat error: %v", err)
+	}
+
+	original, err := os.ReadFile(tmpFile)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+
+	// Existing file: backup holds the previous content
+	cl.AddRelease(NewRelease("1.0.0", "2026-01-04"))
+	if err := cl.WriteFileWithBackup(tmpFile); err != nil {
+		t.Fatalf("WriteFileWithBackup failed: %v", err)
+	}
+	backup, err := os.ReadFile(tmpFile + BackupSuffix)
+	if err != nil {
+		t.Fatalf("expected backup file: %v", err)
+	}
+	if string(backup) != string(original) {
+		t.Error("expected backup to contain the previous file content")
+	}
+	cl2, err := LoadFile(tmpFile)
+	if err != nil {
+		t.Fatalf("failed to read written file: %v", err)
+	}
+	if len(cl2.Releases) != 1 {
+		t.Errorf("expected 1 release, got %d", len(cl2.Releases))
+	}
+}
+
+func TestWriteFileWithBackup_RestoresOnFailure(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "CHANGELOG.json")
+	original := []byte(`{"irVersion":"1.0","project":"original"}`)
+	if err := os.WriteFile(tmpFile, original, 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	// Simulate a crash mid-write that leaves a truncated file
+	errDiskFull := errors.New("disk full")
+	failingWrite := func(path string, data []byte, perm os.FileMode) error {
+		if err := os.WriteFile(path, data[:5], perm); err != nil {
+			return err
+		}
+		return errDiskFull
+	}
+
+	err := writeFileWithBackup(tmpFile, []byte(`{"project":"new"}`), failingWrite)
+	if !errors.Is(err, errDiskFull) {
+		t.Fatalf("expected write error, got %v", err)
+	}
+
+	got, err := os.ReadFile(tmpFile)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	if string(got) != string(original) {
+		t.Errorf("expected original content to be restored, got %q", got)
+	}
+}
+
 func TestWriteFile_InvalidPath(t *testing.T) {
 	cl := New("test")
 	err := cl.WriteFile("/nonexistent/directory/file.json")
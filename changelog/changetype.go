@@ -3,6 +3,7 @@ package changelog
 import (
 	"embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"slices"
 )
@@ -61,12 +62,21 @@ type ChangeType struct {
 	Tier        Tier   `json:"tier"`
 }
 
+// Errors returned when registering custom change types.
+var (
+	ErrEmptyChangeTypeName = errors.New("change type name is required")
+	ErrDuplicateChangeType = errors.New("change type already registered")
+)
+
 // ChangeTypeRegistry holds all change type definitions.
 type ChangeTypeRegistry struct {
 	types    []ChangeType
 	byName   map[string]*ChangeType
 	byTier   map[Tier][]ChangeType
 	nameList []string
+
+	// custom holds the names added with RegisterCustomType.
+	custom map[string]bool
 }
 
 // DefaultRegistry is the global registry loaded from embedded change_types.json.
@@ -96,20 +106,66 @@ func ParseChangeTypes(data []byte) (*ChangeTypeRegistry, error) {
 		return nil, fmt.Errorf("parsing change types JSON: %w", err)
 	}
 
-	registry := &ChangeTypeRegistry{
-		types:  types,
-		byName: make(map[string]*ChangeType),
-		byTier: make(map[Tier][]ChangeType),
+	registry := &ChangeTypeRegistry{types: types}
+	registry.reindex()
+
+	return registry, nil
+}
+
+// reindex rebuilds the name and tier lookups from the types list.
+func (r *ChangeTypeRegistry) reindex() {
+	r.byName = make(map[string]*ChangeType, len(r.types))
+	r.byTier = make(map[Tier][]ChangeType)
+	r.nameList = make([]string, 0, len(r.types))
+
+	for i := range r.types {
+		ct := &r.types[i]
+		r.byName[ct.Name] = ct
+		r.byTier[ct.Tier] = append(r.byTier[ct.Tier], *ct)
+		r.nameList = append(r.nameList, ct.Name)
+	}
+}
+
+// RegisterCustomType adds a team-specific change type to the registry. The
+// type must have a non-empty, unique Name and a valid Tier. Custom types are
+// appended after the built-in types in canonical order.
+func (r *ChangeTypeRegistry) RegisterCustomType(ct ChangeType) error {
+	if ct.Name == "" {
+		return ErrEmptyChangeTypeName
+	}
+	if r.IsValidName(ct.Name) {
+		return fmt.Errorf("%w: %s", ErrDuplicateChangeType, ct.Name)
+	}
+	if !ct.Tier.IsValid() {
+		return fmt.Errorf("%w: %q", ErrInvalidTier, ct.Tier)
 	}
 
-	for i := range types {
-		ct := &types[i]
-		registry.byName[ct.Name] = ct
-		registry.byTier[ct.Tier] = append(registry.byTier[ct.Tier], *ct)
-		registry.nameList = append(registry.nameList, ct.Name)
+	r.types = append(r.types, ct)
+	r.reindex()
+	if r.custom == nil {
+		r.custom = make(map[string]bool)
 	}
+	r.custom[ct.Name] = true
+	return nil
+}
 
-	return registry, nil
+// Unregister removes a change type added with RegisterCustomType. It returns
+// false, leaving the registry unchanged, if name was not registered that way;
+// built-in types cannot be removed, since releases store their entries in
+// fixed fields.
+func (r *ChangeTypeRegistry) Unregister(name string) bool {
+	if !r.custom[name] {
+		return false
+	}
+	idx := slices.IndexFunc(r.types, func(ct ChangeType) bool { return ct.Name == name })
+	if idx < 0 {
+		return false
+	}
+
+	r.types = slices.Delete(r.types, idx, idx+1)
+	delete(r.custom, name)
+	r.reindex()
+	return true
 }
 
 // All returns all change types in canonical order.
@@ -1,6 +1,8 @@
 package changelog
 
 import (
+	"errors"
+	"slices"
 	"testing"
 )
 
@@ -319,3 +321,98 @@ func TestChangeTypeFields(t *testing.T) {
 		}
 	}
 }
+
+func TestRegistryRegisterCustomType(t *testing.T) {
+	r, err := LoadEmbeddedChangeTypes()
+	if err != nil {
+		t.Fatalf("LoadEmbeddedChangeTypes failed: %v", err)
+	}
+
+	ct := ChangeType{Name: "Migrations", Description: "Database migrations", Subtitle: "Schema changes", Tier: TierStandard}
+	if err := r.RegisterCustomType(ct); err != nil {
+		t.Fatalf("RegisterCustomType failed: %v", err)
+	}
+
+	if !slices.Contains(r.Names(), "Migrations") {
+		t.Error("expected Names() to contain Migrations")
+	}
+	if got := r.Get("Migrations"); got == nil || got.Description != "Database migrations" {
+		t.Errorf("expected Get to return the custom type, got %v", got)
+	}
+	if len(r.ByTier(TierStandard)) != 6 {
+		t.Errorf("expected 6 standard types, got %d", len(r.ByTier(TierStandard)))
+	}
+	if !slices.ContainsFunc(r.ByTier(TierStandard), func(c ChangeType) bool { return c.Name == "Migrations" }) {
+		t.Error("expected ByTier(standard) to contain Migrations")
+	}
+	if len(r.FilterByMaxTier(TierStandard)) != 12 {
+		t.Errorf("expected 12 types up to standard, got %d", len(r.FilterByMaxTier(TierStandard)))
+	}
+	if slices.ContainsFunc(r.FilterByMaxTier(TierCore), func(c ChangeType) bool { return c.Name == "Migrations" }) {
+		t.Error("did not expect FilterByMaxTier(core) to contain Migrations")
+	}
+
+	// The default registry is unaffected
+	if DefaultRegistry.IsValidName("Migrations") {
+		t.Error("expected DefaultRegistry to be unaffected")
+	}
+}
+
+func TestRegistryRegisterCustomType_Invalid(t *testing.T) {
+	r, err := LoadEmbeddedChangeTypes()
+	if err != nil {
+		t.Fatalf("LoadEmbeddedChangeTypes failed: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		ct      ChangeType
+		wantErr error
+	}{
+		{"empty_name", ChangeType{Tier: TierCore}, ErrEmptyChangeTypeName},
+		{"duplicate_name", ChangeType{Name: CategoryAdded, Tier: TierCore}, ErrDuplicateChangeType},
+		{"invalid_tier", ChangeType{Name: "Custom", Tier: "bogus"}, ErrInvalidTier},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := r.RegisterCustomType(tt.ct); !errors.Is(err, tt.wantErr) {
+				t.Errorf("RegisterCustomType() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if len(r.All()) != 20 {
+		t.Errorf("expected registry to be unchanged, got %d types", len(r.All()))
+	}
+}
+
+func TestRegistryUnregister(t *testing.T) {
+	r, err := LoadEmbeddedChangeTypes()
+	if err != nil {
+		t.Fatalf("LoadEmbeddedChangeTypes failed: %v", err)
+	}
+
+	if r.Unregister("Internal") {
+		t.Error("expected Unregister to refuse a built-in type")
+	}
+	if !r.IsValidName("Internal") {
+		t.Error("expected built-in Internal to remain registered")
+	}
+
+	if err := r.RegisterCustomType(ChangeType{Name: "Spike", Tier: TierOptional}); err != nil {
+		t.Fatalf("RegisterCustomType failed: %v", err)
+	}
+	if !r.Unregister("Spike") {
+		t.Fatal("expected Unregister to return true for a custom type")
+	}
+	if r.IsValidName("Spike") || slices.Contains(r.Names(), "Spike") {
+		t.Error("expected Spike to be removed")
+	}
+	if len(r.ByTier(TierOptional)) != 4 {
+		t.Errorf("expected 4 optional types, got %d", len(r.ByTier(TierOptional)))
+	}
+	if r.Unregister("Spike") {
+		t.Error("expected Unregister to return false for an unknown type")
+	}
+}
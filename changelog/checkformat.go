@@ -0,0 +1,85 @@
+package changelog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// FormatError reports a problem found by CheckFormat at a 1-based line and
+// column in the input.
+type FormatError struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *FormatError) Unwrap() error {
+	return e.Err
+}
+
+// CheckFormat checks that data is a single well-formed JSON object whose
+// fields are all known to the Changelog schema, without validating values.
+// Syntax errors, type mismatches, and unknown fields (such as misspelled
+// field names) are returned as a *FormatError.
+func CheckFormat(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	var cl Changelog
+	if err := dec.Decode(&cl); err != nil {
+		var syntaxErr *json.SyntaxError
+		var typeErr *json.UnmarshalTypeError
+		switch {
+		case errors.As(err, &syntaxErr):
+			return newFormatError(data, syntaxErr.Offset-1, err)
+		case errors.As(err, &typeErr):
+			return newFormatError(data, typeErr.Offset-1, err)
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			return newFormatError(data, int64(len(data)), fmt.Errorf("unexpected end of JSON input"))
+		default:
+			return newFormatError(data, unknownFieldOffset(data, dec.InputOffset(), err), err)
+		}
+	}
+
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return newFormatError(data, dec.InputOffset(), fmt.Errorf("unexpected data after top-level JSON object"))
+	}
+	return nil
+}
+
+// unknownFieldOffset returns the offset of the key named in an unknown field
+// error. The decoder reports the error after reading the enclosing object,
+// so the last occurrence of the key before end is used. If the key cannot be
+// found, the offset of the end of the object is returned.
+func unknownFieldOffset(data []byte, end int64, err error) int64 {
+	name, ok := strings.CutPrefix(err.Error(), "json: unknown field ")
+	if !ok {
+		return end - 1
+	}
+	keyRegex := regexp.MustCompile(regexp.QuoteMeta(name) + `\s*:`)
+	matches := keyRegex.FindAllIndex(data[:end], -1)
+	if len(matches) == 0 {
+		return end - 1
+	}
+	return int64(matches[len(matches)-1][0])
+}
+
+// newFormatError returns a FormatError for the byte at offset in data.
+func newFormatError(data []byte, offset int64, err error) *FormatError {
+	offset = max(0, min(offset, int64(len(data))))
+	before := data[:offset]
+	return &FormatError{
+		Line:   bytes.Count(before, []byte("\n")) + 1,
+		Column: int(offset) - bytes.LastIndexByte(before, '\n'),
+		Err:    err,
+	}
+}
@@ -0,0 +1,57 @@
+package changelog
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCheckFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		input      string
+		line, col  int
+		errContain string
+	}{
+		{"unknown field", "{\n  \"project\": \"x\",\n  \"relases\": []\n}", 3, 3, `unknown field "relases"`},
+		{"nested unknown field", `{"releases":[{"version":"1.0.0","added":[{"descripton":"x"}]}]}`, 1, 43, `unknown field "descripton"`},
+		{"missing comma", "{\n  \"project\": \"x\"\n  \"releases\": []\n}", 3, 3, "invalid character"},
+		{"wrong type", "{\n  \"project\": 5\n}", 2, 14, "cannot unmarshal number"},
+		{"truncated", "{\n  \"project\": \"x\"", 2, 17, "unexpected end"},
+		{"trailing data", "{} {}", 1, 5, "unexpected data"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckFormat([]byte(tt.input))
+			var fe *FormatError
+			if !errors.As(err, &fe) {
+				t.Fatalf("expected *FormatError, got %v", err)
+			}
+			if fe.Line != tt.line || fe.Column != tt.col {
+				t.Errorf("expected line %d, column %d, got line %d, column %d", tt.line, tt.col, fe.Line, fe.Column)
+			}
+			if !strings.Contains(err.Error(), tt.errContain) {
+				t.Errorf("expected error containing %q, got %q", tt.errContain, err.Error())
+			}
+		})
+	}
+}
+
+func TestCheckFormat_Examples(t *testing.T) {
+	files, err := filepath.Glob("../examples/*/CHANGELOG.json")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no example changelogs found: %v", err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := CheckFormat(data); err != nil {
+			t.Errorf("%s: unexpected error: %v", file, err)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package changelog
+
+import "slices"
+
+// Clone returns a deep copy of the entry.
+func (e Entry) Clone() Entry {
+	e.Affects = slices.Clone(e.Affects)
+	return e
+}
+
+// Clone returns a deep copy of the release, including all category slices
+// and the slices within each entry.
+func (r *Release) Clone() Release {
+	out := *r
+	for _, field := range out.entryFields() {
+		*field = cloneEntries(*field)
+	}
+	return out
+}
+
+// Clone returns a deep copy of the changelog. Mutating the returned
+// changelog, its releases, or their entries does not affect the receiver.
+func (c *Changelog) Clone() *Changelog {
+	out := *c
+	out.Maintainers = slices.Clone(c.Maintainers)
+	out.Bots = slices.Clone(c.Bots)
+	if c.GeneratedAt != nil {
+		t := *c.GeneratedAt
+		out.GeneratedAt = &t
+	}
+	if c.Unreleased != nil {
+		u := c.Unreleased.Clone()
+		out.Unreleased = &u
+	}
+	if c.Releases != nil {
+		out.Releases = make([]Release, len(c.Releases))
+		for i := range c.Releases {
+			out.Releases[i] = c.Releases[i].Clone()
+		}
+	}
+	return &out
+}
+
+// cloneEntries deep-copies a slice of entries, preserving nil.
+func cloneEntries(entries []Entry) []Entry {
+	if entries == nil {
+		return nil
+	}
+	out := make([]Entry, len(entries))
+	for i, e := range entries {
+		out[i] = e.Clone()
+	}
+	return out
+}
@@ -0,0 +1,94 @@
+package changelog
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestChangelogClone(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cl := &Changelog{
+		IRVersion:   "1.0",
+		Project:     "test",
+		Maintainers: []string{"alice"},
+		GeneratedAt: &now,
+		Unreleased:  &Release{Added: []Entry{{Description: "Upcoming"}}},
+		Releases: []Release{
+			{
+				Version: "1.0.0",
+				Date:    "2026-01-01",
+				Added:   []Entry{{Description: "Feature", Affects: []string{"api"}}},
+				Fixed:   []Entry{{Description: "Fix"}},
+			},
+		},
+	}
+
+	clone := cl.Clone()
+
+	clone.Releases[0].Added[0].Description = "Modified"
+	clone.Releases[0].Added[0].Affects[0] = "sdk"
+	clone.Releases[0].Fixed = append(clone.Releases[0].Fixed, Entry{Description: "Extra"})
+	clone.Unreleased.Added[0].Description = "Modified"
+	clone.Maintainers[0] = "bob"
+	*clone.GeneratedAt = now.Add(time.Hour)
+
+	if cl.Releases[0].Added[0].Description != "Feature" {
+		t.Error("mutating clone entry description affected original")
+	}
+	if cl.Releases[0].Added[0].Affects[0] != "api" {
+		t.Error("mutating clone entry Affects affected original")
+	}
+	if len(cl.Releases[0].Fixed) != 1 {
+		t.Error("appending to clone category affected original")
+	}
+	if cl.Unreleased.Added[0].Description != "Upcoming" {
+		t.Error("mutating clone unreleased affected original")
+	}
+	if cl.Maintainers[0] != "alice" {
+		t.Error("mutating clone maintainers affected original")
+	}
+	if !cl.GeneratedAt.Equal(now) {
+		t.Error("mutating clone GeneratedAt affected original")
+	}
+}
+
+func TestReleaseClone_AllCategories(t *testing.T) {
+	var want int
+	rt := reflect.TypeFor[Release]()
+	for i := range rt.NumField() {
+		if rt.Field(i).Type == reflect.TypeFor[[]Entry]() {
+			want++
+		}
+	}
+	r := Release{Version: "1.0.0"}
+	if got := len(r.entryFields()); got != want {
+		t.Fatalf("entryFields returns %d fields, Release has %d entry slices", got, want)
+	}
+	for _, field := range r.entryFields() {
+		*field = []Entry{{Description: "Original"}}
+	}
+
+	clone := r.Clone()
+	for _, field := range clone.entryFields() {
+		(*field)[0].Description = "Modified"
+	}
+
+	for i, field := range r.entryFields() {
+		if (*field)[0].Description != "Original" {
+			t.Errorf("category %d: mutating clone affected original", i)
+		}
+	}
+}
+
+func TestReleaseClone_PreservesNil(t *testing.T) {
+	r := Release{Version: "1.0.0", Added: []Entry{{Description: "Feature"}}}
+
+	clone := r.Clone()
+	if clone.Fixed != nil {
+		t.Error("expected nil category to remain nil")
+	}
+	if clone.Version != "1.0.0" || len(clone.Added) != 1 {
+		t.Errorf("unexpected clone: %+v", clone)
+	}
+}
@@ -0,0 +1,50 @@
+package changelog
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned by GenerateCompareURLs.
+var (
+	ErrEmptyRepository     = errors.New("repository URL is required")
+	ErrUnsupportedRepoHost = errors.New("unsupported repository host")
+)
+
+// GenerateCompareURLs populates CompareURL on every release from Repository
+// and TagPath, replacing any existing values. Each release compares against
+// the next older release; the oldest release links to its tag. The Unreleased
+// section, if present, compares the latest release to HEAD. Supports GitHub
+// and GitLab (including nested groups).
+func (c *Changelog) GenerateCompareURLs() error {
+	return c.GenerateCompareURLsWithPrefix("")
+}
+
+// GenerateCompareURLsWithPrefix is GenerateCompareURLs with versions in tags
+// adjusted by prefix, matching the renderer's VersionPrefix option; see
+// PrefixVersion.
+func (c *Changelog) GenerateCompareURLsWithPrefix(prefix string) error {
+	if c.Repository == "" {
+		return ErrEmptyRepository
+	}
+
+	repo := ParseRepoURL(c.Repository)
+	if !repo.Known() {
+		return fmt.Errorf("%w: %s", ErrUnsupportedRepoHost, c.Repository)
+	}
+
+	for i := range c.Releases {
+		version := PrefixVersion(c.Releases[i].Version, prefix)
+		if i == len(c.Releases)-1 {
+			c.Releases[i].CompareURL = repo.TagURL(c.TagPath, version)
+		} else {
+			c.Releases[i].CompareURL = repo.CompareURL(c.TagPath, PrefixVersion(c.Releases[i+1].Version, prefix), version)
+		}
+	}
+
+	if c.Unreleased != nil && len(c.Releases) > 0 {
+		c.Unreleased.CompareURL = repo.CompareURL(c.TagPath, PrefixVersion(c.Releases[0].Version, prefix), "HEAD")
+	}
+
+	return nil
+}
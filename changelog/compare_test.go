@@ -0,0 +1,125 @@
+package changelog
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGenerateCompareURLs(t *testing.T) {
+	tests := []struct {
+		name           string
+		repo           string
+		tagPath        string
+		wantUnreleased string
+		wantLatest     string
+		wantOldest     string
+	}{
+		{
+			name:           "github",
+			repo:           "https://github.com/example/repo.git",
+			wantUnreleased: "https://github.com/example/repo/compare/v1.1.0...HEAD",
+			wantLatest:     "https://github.com/example/repo/compare/v1.0.0...v1.1.0",
+			wantOldest:     "https://github.com/example/repo/releases/tag/v1.0.0",
+		},
+		{
+			name:           "gitlab",
+			repo:           "https://gitlab.com/example/repo",
+			wantUnreleased: "https://gitlab.com/example/repo/-/compare/v1.1.0...HEAD",
+			wantLatest:     "https://gitlab.com/example/repo/-/compare/v1.0.0...v1.1.0",
+			wantOldest:     "https://gitlab.com/example/repo/-/releases/v1.0.0",
+		},
+		{
+			name:           "gitlab_nested_groups",
+			repo:           "https://gitlab.com/group/subgroup/repo",
+			wantUnreleased: "https://gitlab.com/group/subgroup/repo/-/compare/v1.1.0...HEAD",
+			wantLatest:     "https://gitlab.com/group/subgroup/repo/-/compare/v1.0.0...v1.1.0",
+			wantOldest:     "https://gitlab.com/group/subgroup/repo/-/releases/v1.0.0",
+		},
+		{
+			name:           "tag_path",
+			repo:           "https://github.com/example/monorepo",
+			tagPath:        "sdk/go/",
+			wantUnreleased: "https://github.com/example/monorepo/compare/sdk/go/v1.1.0...HEAD",
+			wantLatest:     "https://github.com/example/monorepo/compare/sdk/go/v1.0.0...sdk/go/v1.1.0",
+			wantOldest:     "https://github.com/example/monorepo/releases/tag/sdk/go/v1.0.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := &Changelog{
+				IRVersion:  IRVersion,
+				Project:    "test",
+				Repository: tt.repo,
+				TagPath:    tt.tagPath,
+				Unreleased: &Release{},
+				Releases: []Release{
+					{Version: "v1.1.0", Date: "2024-02-01"},
+					{Version: "v1.0.0", Date: "2024-01-01"},
+				},
+			}
+			if err := cl.GenerateCompareURLs(); err != nil {
+				t.Fatalf("GenerateCompareURLs failed: %v", err)
+			}
+			if cl.Unreleased.CompareURL != tt.wantUnreleased {
+				t.Errorf("Unreleased.CompareURL = %q, expected %q", cl.Unreleased.CompareURL, tt.wantUnreleased)
+			}
+			if cl.Releases[0].CompareURL != tt.wantLatest {
+				t.Errorf("Releases[0].CompareURL = %q, expected %q", cl.Releases[0].CompareURL, tt.wantLatest)
+			}
+			if cl.Releases[1].CompareURL != tt.wantOldest {
+				t.Errorf("Releases[1].CompareURL = %q, expected %q", cl.Releases[1].CompareURL, tt.wantOldest)
+			}
+		})
+	}
+}
+
+func TestGenerateCompareURLs_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		repo    string
+		wantErr error
+	}{
+		{"empty_repository", "", ErrEmptyRepository},
+		{"unsupported_host", "https://bitbucket.org/example/repo", ErrUnsupportedRepoHost},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := &Changelog{
+				IRVersion:  IRVersion,
+				Project:    "test",
+				Repository: tt.repo,
+				Releases:   []Release{{Version: "v1.1.0"}, {Version: "v1.0.0"}},
+			}
+			if err := cl.GenerateCompareURLs(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("GenerateCompareURLs() error = %v, expected %v", err, tt.wantErr)
+			}
+			if cl.Releases[0].CompareURL != "" {
+				t.Error("expected CompareURL to be left unset on error")
+			}
+		})
+	}
+}
+
+func TestGenerateCompareURLsWithPrefix(t *testing.T) {
+	cl := &Changelog{
+		IRVersion:  IRVersion,
+		Project:    "test",
+		Repository: "https://github.com/example/repo",
+		Unreleased: &Release{},
+		Releases: []Release{
+			{Version: "v1.1.0", Date: "2024-02-01"},
+			{Version: "v1.0.0", Date: "2024-01-01"},
+		},
+	}
+	if err := cl.GenerateCompareURLsWithPrefix(VersionPrefixStrip); err != nil {
+		t.Fatalf("GenerateCompareURLsWithPrefix failed: %v", err)
+	}
+	if want := "https://github.com/example/repo/compare/1.1.0...HEAD"; cl.Unreleased.CompareURL != want {
+		t.Errorf("Unreleased.CompareURL = %q, expected %q", cl.Unreleased.CompareURL, want)
+	}
+	if want := "https://github.com/example/repo/compare/1.0.0...1.1.0"; cl.Releases[0].CompareURL != want {
+		t.Errorf("Releases[0].CompareURL = %q, expected %q", cl.Releases[0].CompareURL, want)
+	}
+}
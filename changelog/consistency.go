@@ -0,0 +1,58 @@
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// tagPathRegex matches slash-separated tag path segments such as "sdk/go".
+var tagPathRegex = regexp.MustCompile(`^[A-Za-z0-9._-]+(?:/[A-Za-z0-9._-]+)*$`)
+
+// ValidateConsistency checks consistency across releases and changelog
+// metadata, beyond the per-release checks of Validate, and returns all
+// violations:
+//   - releases are ordered as checked by ValidateOrder, with a semver
+//     release listed before a higher version reported as
+//     ErrNonMonotonicVersion, a calver, custom, or unversioned release listed
+//     before an older-dated one as ErrNonMonotonicDate, and semver date order
+//     contradictions (such as backported patches) as ErrDateVersionMismatch
+//   - TagPath is a slash-separated path without spaces, usable in tag names
+//     and repository URLs (ErrInvalidTagPath)
+//   - Maintainers has no duplicates (ErrDuplicateMaintainer)
+//   - Bots has no duplicates and does not repeat CommonBots (ErrDuplicateBot)
+//
+// Maintainers and bots are compared case-insensitively, ignoring a leading
+// "@". Returns nil if the changelog is consistent.
+func (c *Changelog) ValidateConsistency() []ValidationError {
+	errs := []ValidationError(c.releaseOrderErrors(ErrNonMonotonicVersion, ErrNonMonotonicDate))
+	add := func(field, message string, err error) {
+		errs = append(errs, ValidationError{Field: field, Message: message, Err: err})
+	}
+
+	if c.TagPath != "" && !tagPathRegex.MatchString(c.TagPath) {
+		add("tag_path", fmt.Sprintf("invalid tag path: %q (use letters, digits, '.', '_', '-' and '/' separators)", c.TagPath), ErrInvalidTagPath)
+	}
+
+	seen := make(map[string]bool)
+	for i, m := range c.Maintainers {
+		key := normalizeAuthor(m)
+		if seen[key] {
+			add(fmt.Sprintf("maintainers[%d]", i), "duplicate maintainer: "+m, ErrDuplicateMaintainer)
+		}
+		seen[key] = true
+	}
+
+	seen = make(map[string]bool)
+	for _, b := range CommonBots {
+		seen[normalizeAuthor(b)] = true
+	}
+	for i, b := range c.Bots {
+		key := normalizeAuthor(b)
+		if seen[key] {
+			add(fmt.Sprintf("bots[%d]", i), "duplicate bot: "+b, ErrDuplicateBot)
+		}
+		seen[key] = true
+	}
+
+	return errs
+}
@@ -0,0 +1,85 @@
+package changelog
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateConsistency(t *testing.T) {
+	tests := []struct {
+		name     string
+		cl       Changelog
+		wantErrs []error
+	}{
+		{
+			name: "consistent",
+			cl: Changelog{
+				TagPath:     "sdk/go",
+				Maintainers: []string{"alice", "bob"},
+				Bots:        []string{"my-bot"},
+				Releases: []Release{
+					{Version: "1.1.0", Date: "2026-02-01"},
+					{Version: "1.0.0", Date: "2026-01-01"},
+				},
+			},
+		},
+		{
+			name: "non-monotonic version",
+			cl: Changelog{Releases: []Release{
+				{Version: "1.0.0", Date: "2026-01-01"},
+				{Version: "1.1.0", Date: "2026-02-01"},
+			}},
+			wantErrs: []error{ErrNonMonotonicVersion},
+		},
+		{
+			name: "backported patch dated after newer version",
+			cl: Changelog{Releases: []Release{
+				{Version: "2.0.0", Date: "2026-01-01"},
+				{Version: "1.9.1", Date: "2026-02-01"},
+			}},
+			wantErrs: []error{ErrDateVersionMismatch},
+		},
+		{
+			name: "calver skips version order",
+			cl: Changelog{Versioning: VersioningCalVer, Releases: []Release{
+				{Version: "2026.1.0", Date: "2026-02-01"},
+				{Version: "2026.2.0", Date: "2026-01-01"},
+			}},
+		},
+		{
+			name: "calver non-monotonic date",
+			cl: Changelog{Versioning: VersioningCalVer, Releases: []Release{
+				{Version: "2026.1.0", Date: "2026-01-01"},
+				{Version: "2026.2.0", Date: "2026-02-01"},
+			}},
+			wantErrs: []error{ErrNonMonotonicDate},
+		},
+		{
+			name:     "invalid tag path",
+			cl:       Changelog{TagPath: "sdk go/"},
+			wantErrs: []error{ErrInvalidTagPath},
+		},
+		{
+			name: "duplicate maintainers and bots",
+			cl: Changelog{
+				Maintainers: []string{"alice", "@Alice"},
+				Bots:        []string{"my-bot", "dependabot[bot]", "my-bot"},
+			},
+			wantErrs: []error{ErrDuplicateMaintainer, ErrDuplicateBot, ErrDuplicateBot},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.cl.ValidateConsistency()
+			if len(errs) != len(tt.wantErrs) {
+				t.Fatalf("expected %d errors, got %d: %v", len(tt.wantErrs), len(errs), errs)
+			}
+			for i, want := range tt.wantErrs {
+				if !errors.Is(&errs[i], want) {
+					t.Errorf("error %d: expected %v, got %v", i, want, errs[i].Err)
+				}
+			}
+		})
+	}
+}
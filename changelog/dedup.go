@@ -0,0 +1,56 @@
+package changelog
+
+import "strings"
+
+// DedupEntries removes duplicate entries within each category of each
+// release, including Unreleased, keeping the first occurrence. Entries are
+// duplicates if they have the same Description (case-insensitive), Issue, PR,
+// CVE, and Commit. Entries in different categories or releases are never
+// considered duplicates. Returns the number of entries removed.
+func (c *Changelog) DedupEntries() int {
+	removed := 0
+	if c.Unreleased != nil {
+		removed += c.Unreleased.dedupEntries()
+	}
+	for i := range c.Releases {
+		removed += c.Releases[i].dedupEntries()
+	}
+	return removed
+}
+
+// dedupEntries removes duplicate entries within each category of r and
+// returns the number removed.
+func (r *Release) dedupEntries() int {
+	removed := 0
+	for _, cat := range r.Categories() {
+		field := r.entriesField(cat.Name)
+		seen := make(map[dedupKey]bool, len(*field))
+		kept := (*field)[:0]
+		for _, e := range *field {
+			key := newDedupKey(e)
+			if seen[key] {
+				removed++
+				continue
+			}
+			seen[key] = true
+			kept = append(kept, e)
+		}
+		*field = kept
+	}
+	return removed
+}
+
+// dedupKey holds the entry fields compared by DedupEntries.
+type dedupKey struct {
+	description, issue, pr, cve, commit string
+}
+
+func newDedupKey(e Entry) dedupKey {
+	return dedupKey{
+		description: strings.ToLower(e.Description),
+		issue:       e.Issue,
+		pr:          e.PR,
+		cve:         e.CVE,
+		commit:      e.Commit,
+	}
+}
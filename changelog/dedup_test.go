@@ -0,0 +1,44 @@
+package changelog
+
+import "testing"
+
+func TestChangelogDedupEntries(t *testing.T) {
+	cl := &Changelog{
+		IRVersion:  IRVersion,
+		Project:    "test",
+		Unreleased: &Release{Fixed: []Entry{{Description: "Bug"}, {Description: "Bug"}}},
+		Releases: []Release{
+			{
+				Version: "1.1.0",
+				Added: []Entry{
+					{Description: "Feature A", PR: "1"},
+					{Description: "feature a", PR: "1"},
+					{Description: "Feature A", PR: "2"},
+					{Description: "Feature B"},
+					{Description: "FEATURE A", PR: "1"},
+				},
+				Changed: []Entry{{Description: "Feature B"}},
+			},
+			{Version: "1.0.0", Added: []Entry{{Description: "Feature B"}}},
+		},
+	}
+
+	if got := cl.DedupEntries(); got != 3 {
+		t.Errorf("expected 3 entries removed, got %d", got)
+	}
+
+	added := cl.Releases[0].Added
+	if len(added) != 3 || added[0].Description != "Feature A" || added[1].PR != "2" || added[2].Description != "Feature B" {
+		t.Errorf("unexpected added entries: %+v", added)
+	}
+	if len(cl.Releases[0].Changed) != 1 || len(cl.Releases[1].Added) != 1 {
+		t.Error("expected entries in other categories and releases to be kept")
+	}
+	if len(cl.Unreleased.Fixed) != 1 {
+		t.Errorf("expected unreleased duplicates removed, got %+v", cl.Unreleased.Fixed)
+	}
+
+	if got := cl.DedupEntries(); got != 0 {
+		t.Errorf("expected no entries removed on second pass, got %d", got)
+	}
+}
@@ -0,0 +1,118 @@
+package changelog
+
+import "reflect"
+
+// UnreleasedVersion is the version key used for the Unreleased section in
+// a ChangelogDiff.
+const UnreleasedVersion = "unreleased"
+
+// ChangelogDiff describes the differences between two changelogs.
+type ChangelogDiff struct {
+	AddedReleases   []Release     `json:"addedReleases,omitempty"`
+	RemovedReleases []Release     `json:"removedReleases,omitempty"`
+	ChangedReleases []ReleaseDiff `json:"changedReleases,omitempty"`
+}
+
+// ReleaseDiff describes entry changes within a release present in both changelogs.
+type ReleaseDiff struct {
+	Version    string         `json:"version"`
+	Categories []CategoryDiff `json:"categories"`
+}
+
+// CategoryDiff lists the entries added to and removed from one category.
+type CategoryDiff struct {
+	Name    string  `json:"name"`
+	Added   []Entry `json:"added,omitempty"`
+	Removed []Entry `json:"removed,omitempty"`
+}
+
+// IsEmpty returns true if the diff contains no differences.
+func (d ChangelogDiff) IsEmpty() bool {
+	return len(d.AddedReleases) == 0 && len(d.RemovedReleases) == 0 && len(d.ChangedReleases) == 0
+}
+
+// Diff compares c (old) with other (new). Releases are matched by version;
+// the Unreleased section is compared under UnreleasedVersion. Entries are
+// matched by full equality, so an edited entry appears as one removal and
+// one addition. Added and changed releases follow other's order; removed
+// releases follow c's order.
+func (c *Changelog) Diff(other *Changelog) ChangelogDiff {
+	var d ChangelogDiff
+
+	oldByVersion := make(map[string]*Release, len(c.Releases))
+	for i := range c.Releases {
+		oldByVersion[c.Releases[i].Version] = &c.Releases[i]
+	}
+	newByVersion := make(map[string]*Release, len(other.Releases))
+	for i := range other.Releases {
+		newByVersion[other.Releases[i].Version] = &other.Releases[i]
+	}
+
+	if rd, changed := diffRelease(UnreleasedVersion, c.Unreleased, other.Unreleased); changed {
+		d.ChangedReleases = append(d.ChangedReleases, rd)
+	}
+
+	for i := range other.Releases {
+		r := &other.Releases[i]
+		old, ok := oldByVersion[r.Version]
+		if !ok {
+			d.AddedReleases = append(d.AddedReleases, *r)
+			continue
+		}
+		if rd, changed := diffRelease(r.Version, old, r); changed {
+			d.ChangedReleases = append(d.ChangedReleases, rd)
+		}
+	}
+
+	for i := range c.Releases {
+		if _, ok := newByVersion[c.Releases[i].Version]; !ok {
+			d.RemovedReleases = append(d.RemovedReleases, c.Releases[i])
+		}
+	}
+
+	return d
+}
+
+// diffRelease compares the entries of two releases category by category.
+// Either release may be nil.
+func diffRelease(version string, old, updated *Release) (ReleaseDiff, bool) {
+	rd := ReleaseDiff{Version: version}
+	if old == nil {
+		old = &Release{}
+	}
+	if updated == nil {
+		updated = &Release{}
+	}
+
+	oldCats, newCats := old.categoryMap(), updated.categoryMap()
+	for _, name := range DefaultRegistry.Names() {
+		added := entriesNotIn(newCats[name], oldCats[name])
+		removed := entriesNotIn(oldCats[name], newCats[name])
+		if len(added) > 0 || len(removed) > 0 {
+			rd.Categories = append(rd.Categories, CategoryDiff{Name: name, Added: added, Removed: removed})
+		}
+	}
+
+	return rd, len(rd.Categories) > 0
+}
+
+// entriesNotIn returns the entries in a that have no matching entry in b,
+// treating both as multisets.
+func entriesNotIn(a, b []Entry) []Entry {
+	used := make([]bool, len(b))
+	var result []Entry
+	for _, e := range a {
+		found := false
+		for j := range b {
+			if !used[j] && reflect.DeepEqual(e, b[j]) {
+				used[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, e)
+		}
+	}
+	return result
+}
@@ -0,0 +1,109 @@
+package changelog
+
+import "testing"
+
+func TestChangelogDiff(t *testing.T) {
+	old := &Changelog{
+		IRVersion: IRVersion,
+		Project:   "test",
+		Releases: []Release{
+			{
+				Version: "1.1.0",
+				Date:    "2024-02-01",
+				Added:   []Entry{{Description: "Feature A"}, {Description: "Feature B"}},
+				Fixed:   []Entry{{Description: "Bug 1"}},
+			},
+			{Version: "1.0.0", Date: "2024-01-01", Added: []Entry{{Description: "Initial"}}},
+			{Version: "0.9.0", Date: "2023-12-01", Added: []Entry{{Description: "Beta"}}},
+		},
+	}
+	updated := &Changelog{
+		IRVersion:  IRVersion,
+		Project:    "test",
+		Unreleased: &Release{Added: []Entry{{Description: "WIP"}}},
+		Releases: []Release{
+			{Version: "1.2.0", Date: "2024-03-01", Fixed: []Entry{{Description: "Bug 2"}}},
+			{
+				Version: "1.1.0",
+				Date:    "2024-02-01",
+				Added:   []Entry{{Description: "Feature A"}, {Description: "Feature C"}},
+				Fixed:   []Entry{{Description: "Bug 1"}},
+			},
+			{Version: "1.0.0", Date: "2024-01-01", Added: []Entry{{Description: "Initial"}}},
+		},
+	}
+
+	d := old.Diff(updated)
+
+	if d.IsEmpty() {
+		t.Fatal("expected differences")
+	}
+	if len(d.AddedReleases) != 1 || d.AddedReleases[0].Version != "1.2.0" {
+		t.Errorf("unexpected added releases: %+v", d.AddedReleases)
+	}
+	if len(d.RemovedReleases) != 1 || d.RemovedReleases[0].Version != "0.9.0" {
+		t.Errorf("unexpected removed releases: %+v", d.RemovedReleases)
+	}
+	if len(d.ChangedReleases) != 2 {
+		t.Fatalf("expected 2 changed releases, got %d: %+v", len(d.ChangedReleases), d.ChangedReleases)
+	}
+
+	unreleased := d.ChangedReleases[0]
+	if unreleased.Version != UnreleasedVersion || len(unreleased.Categories) != 1 ||
+		len(unreleased.Categories[0].Added) != 1 || unreleased.Categories[0].Added[0].Description != "WIP" {
+		t.Errorf("unexpected unreleased diff: %+v", unreleased)
+	}
+
+	changed := d.ChangedReleases[1]
+	if changed.Version != "1.1.0" || len(changed.Categories) != 1 {
+		t.Fatalf("unexpected changed release: %+v", changed)
+	}
+	cat := changed.Categories[0]
+	if cat.Name != CategoryAdded {
+		t.Errorf("expected Added category, got %s", cat.Name)
+	}
+	if len(cat.Added) != 1 || cat.Added[0].Description != "Feature C" {
+		t.Errorf("unexpected added entries: %+v", cat.Added)
+	}
+	if len(cat.Removed) != 1 || cat.Removed[0].Description != "Feature B" {
+		t.Errorf("unexpected removed entries: %+v", cat.Removed)
+	}
+}
+
+func TestChangelogDiff_Identical(t *testing.T) {
+	old := &Changelog{
+		IRVersion: IRVersion,
+		Project:   "test",
+		Releases: []Release{
+			{Version: "1.1.0", Date: "2024-02-01", Fixed: []Entry{{Description: "Bug 1"}}},
+			{Version: "1.0.0", Date: "2024-01-01", Added: []Entry{{Description: "Initial"}}},
+		},
+	}
+
+	if d := old.Diff(old.Clone()); !d.IsEmpty() {
+		t.Errorf("expected no differences, got %+v", d)
+	}
+}
+
+func TestChangelogDiff_ModifiedEntry(t *testing.T) {
+	old := &Changelog{
+		IRVersion: IRVersion,
+		Project:   "test",
+		Releases: []Release{
+			{Version: "1.1.0", Date: "2024-02-01", Fixed: []Entry{{Description: "Bug 1"}}},
+			{Version: "1.0.0", Date: "2024-01-01", Added: []Entry{{Description: "Initial"}}},
+		},
+	}
+	updated := old.Clone()
+	updated.Releases[0].Fixed[0].PR = "42"
+
+	d := old.Diff(updated)
+
+	if len(d.ChangedReleases) != 1 {
+		t.Fatalf("expected 1 changed release, got %d", len(d.ChangedReleases))
+	}
+	cat := d.ChangedReleases[0].Categories[0]
+	if len(cat.Added) != 1 || len(cat.Removed) != 1 || cat.Added[0].PR != "42" {
+		t.Errorf("expected modified entry as removal plus addition, got %+v", cat)
+	}
+}
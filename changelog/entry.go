@@ -9,7 +9,28 @@ type Entry struct {
 	Author      string `json:"author,omitempty"`
 	Breaking    bool   `json:"breaking,omitempty"`
 
-	// SBOM metadata
+	// ExternalURL links the entry to an external tracker such as JIRA or
+	// Linear. When set, renderers use it as the link target for the Issue
+	// (or, without an Issue, the PR) reference instead of a repository URL.
+	ExternalURL string `json:"externalUrl,omitempty"`
+
+	// Notes holds an optional longer explanation that extends the short
+	// Description.
+	Notes string `json:"notes,omitempty"`
+
+	// Affects lists the services, packages, or components this entry affects.
+	Affects []string `json:"affects,omitempty"`
+
+	// Since records the version in which a deprecated feature was first
+	// deprecated. Intended for Deprecated category entries.
+	Since string `json:"since,omitempty"`
+
+	// PlannedRemoval records the version or date when a deprecated feature
+	// is expected to be removed. Intended for Deprecated category entries.
+	PlannedRemoval string `json:"plannedRemoval,omitempty"`
+
+	// SBOM metadata. Component also names the component an entry belongs
+	// to in a changelog shared by several components, e.g. in a monorepo.
 	Component        string `json:"component,omitempty"`
 	ComponentVersion string `json:"componentVersion,omitempty"`
 	License          string `json:"license,omitempty"`
@@ -43,6 +64,12 @@ func (e Entry) WithPR(pr string) Entry {
 	return e
 }
 
+// WithExternalURL sets the external tracker URL.
+func (e Entry) WithExternalURL(url string) Entry {
+	e.ExternalURL = url
+	return e
+}
+
 // WithCommit sets the commit SHA.
 func (e Entry) WithCommit(commit string) Entry {
 	e.Commit = commit
@@ -61,6 +88,31 @@ func (e Entry) WithBreaking() Entry {
 	return e
 }
 
+// WithAffects sets the components affected by the entry.
+func (e Entry) WithAffects(components ...string) Entry {
+	e.Affects = components
+	return e
+}
+
+// WithSince sets the version since which the feature has been deprecated.
+func (e Entry) WithSince(version string) Entry {
+	e.Since = version
+	return e
+}
+
+// WithPlannedRemoval sets the version or date when the deprecated feature
+// is expected to be removed.
+func (e Entry) WithPlannedRemoval(versionOrDate string) Entry {
+	e.PlannedRemoval = versionOrDate
+	return e
+}
+
+// WithNotes sets the extended notes for the entry.
+func (e Entry) WithNotes(notes string) Entry {
+	e.Notes = notes
+	return e
+}
+
 // WithCVE sets CVE identifier for security entries.
 func (e Entry) WithCVE(cve string) Entry {
 	e.CVE = cve
@@ -28,6 +28,13 @@ func TestEntryWithPR(t *testing.T) {
 	}
 }
 
+func TestEntryWithExternalURL(t *testing.T) {
+	e := NewEntry("Fix login").WithIssue("PROJ-123").WithExternalURL("https://example.atlassian.net/browse/PROJ-123")
+	if e.ExternalURL != "https://example.atlassian.net/browse/PROJ-123" {
+		t.Errorf("expected external URL, got %q", e.ExternalURL)
+	}
+}
+
 func TestEntryWithCommit(t *testing.T) {
 	e := NewEntry("Refactor").WithCommit("abc123")
 	if e.Commit != "abc123" {
@@ -49,6 +56,27 @@ func TestEntryWithBreaking(t *testing.T) {
 	}
 }
 
+func TestEntryWithSince(t *testing.T) {
+	e := NewEntry("Old API").WithSince("v1.2.0")
+	if e.Since != "v1.2.0" {
+		t.Errorf("expected Since 'v1.2.0', got %q", e.Since)
+	}
+}
+
+func TestEntryWithNotes(t *testing.T) {
+	e := NewEntry("New auth flow").WithNotes("Tokens now refresh automatically.")
+	if e.Notes != "Tokens now refresh automatically." {
+		t.Errorf("unexpected Notes %q", e.Notes)
+	}
+}
+
+func TestEntryWithPlannedRemoval(t *testing.T) {
+	e := NewEntry("Old API").WithPlannedRemoval("v2.0.0")
+	if e.PlannedRemoval != "v2.0.0" {
+		t.Errorf("expected PlannedRemoval 'v2.0.0', got %q", e.PlannedRemoval)
+	}
+}
+
 func TestEntryWithCVE(t *testing.T) {
 	e := NewEntry("Security fix").WithCVE("CVE-2026-12345")
 	if e.CVE != "CVE-2026-12345" {
@@ -100,6 +128,13 @@ func TestEntryWithComponent(t *testing.T) {
 	}
 }
 
+func TestEntryWithAffects(t *testing.T) {
+	e := NewEntry("Change auth flow").WithAffects("api", "sdk")
+	if len(e.Affects) != 2 || e.Affects[0] != "api" || e.Affects[1] != "sdk" {
+		t.Errorf("expected affects [api sdk], got %v", e.Affects)
+	}
+}
+
 func TestEntryIsSecurityEntry(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -0,0 +1,60 @@
+package changelog
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// IR version migration errors.
+var (
+	ErrAlreadyCurrentVersion = errors.New("changelog is already at the target IR version")
+	ErrDowngradeNotSupported = errors.New("IR version downgrade is not supported")
+)
+
+// SupportedIRVersions lists the IR versions that can be read and validated,
+// oldest first. New changelogs are created with IRVersion.
+var SupportedIRVersions = []string{"1.0", "1.1"}
+
+// irMigrations holds the transformation from each supported IR version to
+// the next: irMigrations[i] upgrades SupportedIRVersions[i] to
+// SupportedIRVersions[i+1].
+var irMigrations = []func(*Changelog) error{
+	// 1.0 -> 1.1: no structural changes.
+	func(*Changelog) error { return nil },
+}
+
+// IsSupportedIRVersion reports whether v is in SupportedIRVersions.
+func IsSupportedIRVersion(v string) bool {
+	return slices.Contains(SupportedIRVersions, v)
+}
+
+// MigrateIRVersion upgrades the changelog in place from its IRVersion to
+// targetVersion, applying the transformation for each intermediate version
+// in turn. Returns ErrAlreadyCurrentVersion if the changelog is already at
+// targetVersion, ErrDowngradeNotSupported if targetVersion is older, and
+// ErrInvalidIRVersion if either version is not supported.
+func (c *Changelog) MigrateIRVersion(targetVersion string) error {
+	from := slices.Index(SupportedIRVersions, c.IRVersion)
+	if from < 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidIRVersion, c.IRVersion)
+	}
+	to := slices.Index(SupportedIRVersions, targetVersion)
+	if to < 0 {
+		return fmt.Errorf("%w: %s", ErrInvalidIRVersion, targetVersion)
+	}
+	switch {
+	case to == from:
+		return fmt.Errorf("%w: %s", ErrAlreadyCurrentVersion, targetVersion)
+	case to < from:
+		return fmt.Errorf("%w: %s to %s", ErrDowngradeNotSupported, c.IRVersion, targetVersion)
+	}
+
+	for i := from; i < to; i++ {
+		if err := irMigrations[i](c); err != nil {
+			return fmt.Errorf("failed to migrate IR version %s to %s: %w", SupportedIRVersions[i], SupportedIRVersions[i+1], err)
+		}
+		c.IRVersion = SupportedIRVersions[i+1]
+	}
+	return nil
+}
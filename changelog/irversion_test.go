@@ -0,0 +1,51 @@
+package changelog
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMigrateIRVersion(t *testing.T) {
+	if len(irMigrations) != len(SupportedIRVersions)-1 {
+		t.Fatalf("expected %d migrations, got %d", len(SupportedIRVersions)-1, len(irMigrations))
+	}
+
+	cl := New("test")
+	cl.AddRelease(Release{Version: "1.0.0", Date: "2026-01-01", Added: []Entry{{Description: "Feature"}}})
+
+	if err := cl.MigrateIRVersion("1.1"); err != nil {
+		t.Fatalf("MigrateIRVersion failed: %v", err)
+	}
+	if cl.IRVersion != "1.1" {
+		t.Errorf("expected IR version 1.1, got %s", cl.IRVersion)
+	}
+	if len(cl.Releases) != 1 || len(cl.Releases[0].Added) != 1 {
+		t.Errorf("expected releases to be unchanged, got %+v", cl.Releases)
+	}
+	if result := cl.Validate(); !result.Valid {
+		t.Errorf("expected migrated changelog to be valid, got %v", result.Errors)
+	}
+
+	tests := []struct {
+		name    string
+		from    string
+		target  string
+		wantErr error
+	}{
+		{"already current", "1.1", "1.1", ErrAlreadyCurrentVersion},
+		{"downgrade", "1.1", "1.0", ErrDowngradeNotSupported},
+		{"unknown target", "1.0", "2.0", ErrInvalidIRVersion},
+		{"unknown source", "0.9", "1.1", ErrInvalidIRVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := &Changelog{IRVersion: tt.from, Project: "test"}
+			if err := cl.MigrateIRVersion(tt.target); !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+			if cl.IRVersion != tt.from {
+				t.Errorf("expected IR version to stay %s, got %s", tt.from, cl.IRVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package changelog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// LintRule identifies an opinionated style check run by Lint.
+type LintRule string
+
+// Lint rules. Unlike validation, these enforce style rather than correctness.
+const (
+	LintRuleCapitalized      LintRule = "capitalized"
+	LintRuleNoTrailingPeriod LintRule = "no-trailing-period"
+	LintRuleNoFirstPerson    LintRule = "no-first-person"
+	LintRuleSecurityAdvisory LintRule = "security-advisory"
+	LintRuleAddedOrFixed     LintRule = "added-or-fixed"
+)
+
+// LintRules lists all lint rules in the order they are reported.
+var LintRules = []LintRule{
+	LintRuleCapitalized,
+	LintRuleNoTrailingPeriod,
+	LintRuleNoFirstPerson,
+	LintRuleSecurityAdvisory,
+	LintRuleAddedOrFixed,
+}
+
+// ErrUnknownLintRule is returned when an unrecognized lint rule name is provided.
+var ErrUnknownLintRule = errors.New("unknown lint rule")
+
+// ParseLintRule returns the LintRule with the given name.
+func ParseLintRule(name string) (LintRule, error) {
+	for _, rule := range LintRules {
+		if string(rule) == name {
+			return rule, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrUnknownLintRule, name)
+}
+
+// LintViolation describes a single style rule violation.
+type LintViolation struct {
+	Rule    LintRule `json:"rule"`
+	Path    string   `json:"path"`
+	Message string   `json:"message"`
+}
+
+// Lint runs the given style rules against the changelog and returns any
+// violations, using the same path notation as ValidateRich. If rules is
+// empty, all rules in LintRules are run.
+func (c *Changelog) Lint(rules []LintRule) []LintViolation {
+	if len(rules) == 0 {
+		rules = LintRules
+	}
+	enabled := make(map[LintRule]bool, len(rules))
+	for _, rule := range rules {
+		enabled[rule] = true
+	}
+
+	var violations []LintViolation
+	if c.Unreleased != nil {
+		violations = append(violations, lintRelease(c.Unreleased, "unreleased", enabled, true)...)
+	}
+	for i := range c.Releases {
+		violations = append(violations, lintRelease(&c.Releases[i], fmt.Sprintf("releases[%d]", i), enabled, false)...)
+	}
+	return violations
+}
+
+func lintRelease(r *Release, field string, enabled map[LintRule]bool, isUnreleased bool) []LintViolation {
+	var violations []LintViolation
+
+	for _, cat := range r.Categories() {
+		catField := field + "." + categoryField(cat.Name)
+		for i, entry := range cat.Entries {
+			path := fmt.Sprintf("%s[%d]", catField, i)
+			desc := strings.TrimSpace(entry.Description)
+
+			if enabled[LintRuleCapitalized] {
+				if first, _ := utf8.DecodeRuneInString(desc); unicode.IsLower(first) {
+					violations = append(violations, LintViolation{
+						Rule:    LintRuleCapitalized,
+						Path:    path + ".description",
+						Message: "Description should start with a capital letter",
+					})
+				}
+			}
+			if enabled[LintRuleNoTrailingPeriod] && strings.HasSuffix(desc, ".") && !strings.HasSuffix(desc, "...") {
+				violations = append(violations, LintViolation{
+					Rule:    LintRuleNoTrailingPeriod,
+					Path:    path + ".description",
+					Message: "Description should not end with a period",
+				})
+			}
+			if enabled[LintRuleNoFirstPerson] && strings.HasPrefix(desc, "I ") {
+				violations = append(violations, LintViolation{
+					Rule:    LintRuleNoFirstPerson,
+					Path:    path + ".description",
+					Message: `Description should not begin with "I "`,
+				})
+			}
+			if enabled[LintRuleSecurityAdvisory] && cat.Name == CategorySecurity && entry.CVE == "" && entry.GHSA == "" {
+				violations = append(violations, LintViolation{
+					Rule:    LintRuleSecurityAdvisory,
+					Path:    path,
+					Message: "Security entry should reference a CVE or GHSA",
+				})
+			}
+		}
+	}
+
+	if enabled[LintRuleAddedOrFixed] && !isUnreleased && !r.IsMaintenanceOnly() &&
+		len(r.Added) == 0 && len(r.Fixed) == 0 {
+		violations = append(violations, LintViolation{
+			Rule:    LintRuleAddedOrFixed,
+			Path:    field,
+			Message: fmt.Sprintf("Release %s should have at least one Added or Fixed entry", r.Version),
+		})
+	}
+
+	return violations
+}
+
+// categoryField returns the path segment for a category name,
+// e.g. "Known Issues" becomes "known_issues".
+func categoryField(name string) string {
+	return strings.ReplaceAll(strings.ToLower(name), " ", "_")
+}
@@ -0,0 +1,102 @@
+package changelog
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLint_AllRules(t *testing.T) {
+	cl := &Changelog{
+		IRVersion:  IRVersion,
+		Project:    "test-project",
+		Unreleased: &Release{Changed: []Entry{{Description: "work in progress"}}},
+		Releases: []Release{{
+			Version: "1.1.0",
+			Date:    "2024-02-01",
+			Changed: []Entry{{Description: "I refactored the parser."}},
+			Security: []Entry{
+				{Description: "Fix XSS", CVE: "CVE-2024-12345"},
+				{Description: "Fix token leak"},
+			},
+		}, {
+			Version:      "1.0.1",
+			Date:         "2024-01-15",
+			Dependencies: []Entry{{Description: "Bump deps..."}},
+		}, {
+			Version: "1.0.0",
+			Date:    "2024-01-01",
+			Added:   []Entry{{Description: "Initial release"}},
+		}},
+	}
+
+	violations := cl.Lint(nil)
+
+	expected := []LintViolation{
+		{Rule: LintRuleCapitalized, Path: "unreleased.changed[0].description"},
+		{Rule: LintRuleSecurityAdvisory, Path: "releases[0].security[1]"},
+		{Rule: LintRuleNoTrailingPeriod, Path: "releases[0].changed[0].description"},
+		{Rule: LintRuleNoFirstPerson, Path: "releases[0].changed[0].description"},
+		{Rule: LintRuleAddedOrFixed, Path: "releases[0]"},
+	}
+
+	if len(violations) != len(expected) {
+		t.Fatalf("expected %d violations, got %d: %+v", len(expected), len(violations), violations)
+	}
+	for i, want := range expected {
+		if violations[i].Rule != want.Rule || violations[i].Path != want.Path {
+			t.Errorf("violation %d: expected %s at %s, got %s at %s",
+				i, want.Rule, want.Path, violations[i].Rule, violations[i].Path)
+		}
+		if violations[i].Message == "" {
+			t.Errorf("violation %d: expected a message", i)
+		}
+	}
+}
+
+func TestLint_SelectedRules(t *testing.T) {
+	cl := &Changelog{
+		IRVersion:  IRVersion,
+		Project:    "test-project",
+		Unreleased: &Release{Changed: []Entry{{Description: "work in progress"}}},
+		Releases: []Release{{
+			Version:  "1.0.0",
+			Date:     "2024-01-01",
+			Security: []Entry{{Description: "Fix token leak"}},
+		}},
+	}
+
+	violations := cl.Lint([]LintRule{LintRuleSecurityAdvisory})
+
+	if len(violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d: %+v", len(violations), violations)
+	}
+	if violations[0].Rule != LintRuleSecurityAdvisory {
+		t.Errorf("expected security-advisory violation, got %s", violations[0].Rule)
+	}
+}
+
+func TestLint_Clean(t *testing.T) {
+	cl := New("test-project")
+	cl.AddRelease(Release{
+		Version: "1.0.0",
+		Date:    "2024-01-01",
+		Added:   []Entry{{Description: "Initial release"}},
+	})
+
+	if violations := cl.Lint(nil); len(violations) != 0 {
+		t.Errorf("expected no violations, got %+v", violations)
+	}
+}
+
+func TestParseLintRule(t *testing.T) {
+	for _, rule := range LintRules {
+		got, err := ParseLintRule(string(rule))
+		if err != nil || got != rule {
+			t.Errorf("ParseLintRule(%q) = %q, %v", rule, got, err)
+		}
+	}
+
+	if _, err := ParseLintRule("bogus"); !errors.Is(err, ErrUnknownLintRule) {
+		t.Errorf("expected ErrUnknownLintRule, got %v", err)
+	}
+}
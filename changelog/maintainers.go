@@ -0,0 +1,99 @@
+package changelog
+
+import (
+	"bufio"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// maintainerShare is the fraction of commits an author must exceed to be
+// suggested by InferMaintainersFromGit.
+const maintainerShare = 0.10
+
+// shortlogRegex matches "git shortlog -sne" lines: "   42\tJane Doe <jane@example.com>".
+var shortlogRegex = regexp.MustCompile(`^\s*(\d+)\t(.*?)(?:\s+<([^>]*)>)?$`)
+
+// AddMaintainerEmails maps author emails to GitHub usernames, e.g.
+// {"jane@corp.example.com": "janedoe"}, and adds each email whose username
+// is a maintainer to Maintainers, so that IsTeamMemberByNameAndEmail matches
+// commits made with that email. Returns the number of emails added.
+func (c *Changelog) AddMaintainerEmails(emailToUsername map[string]string) int {
+	emails := make([]string, 0, len(emailToUsername))
+	for email := range emailToUsername {
+		emails = append(emails, email)
+	}
+	slices.Sort(emails)
+
+	added := 0
+	for _, email := range emails {
+		username := normalizeAuthor(emailToUsername[email])
+		isMaintainer := slices.ContainsFunc(c.Maintainers, func(m string) bool { return normalizeAuthor(m) == username })
+		hasEmail := slices.ContainsFunc(c.Maintainers, func(m string) bool { return normalizeAuthor(m) == normalizeAuthor(email) })
+		if isMaintainer && !hasEmail {
+			c.Maintainers = append(c.Maintainers, email)
+			added++
+		}
+	}
+	return added
+}
+
+// InferMaintainersFromGit suggests maintainers from the git history of the
+// current directory. It runs "git shortlog -sne" over the last maxCommits
+// commits of HEAD (all commits if maxCommits is zero or less) and returns
+// authors with more than 10% of those commits, most active first. Authors
+// are identified by email, or by name if they have no email. Known bots are
+// skipped. The changelog is not modified.
+func (c *Changelog) InferMaintainersFromGit(maxCommits int) ([]string, error) {
+	args := []string{"shortlog", "-sne"}
+	if maxCommits > 0 {
+		args = append(args, "--max-count="+strconv.Itoa(maxCommits))
+	}
+	args = append(args, "HEAD")
+
+	output, err := exec.Command("git", args...).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run git shortlog: %w", err)
+	}
+	return c.maintainersFromShortlog(string(output)), nil
+}
+
+// maintainersFromShortlog returns the authors in "git shortlog -sne" output
+// with more than maintainerShare of the commits, excluding bots.
+func (c *Changelog) maintainersFromShortlog(output string) []string {
+	type author struct {
+		id    string
+		count int
+	}
+	var authors []author
+	total := 0
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		m := shortlogRegex.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		count, _ := strconv.Atoi(m[1])
+		total += count
+		if c.isBot(m[2]) {
+			continue
+		}
+		id := m[3]
+		if id == "" {
+			id = m[2]
+		}
+		authors = append(authors, author{id: id, count: count})
+	}
+
+	var maintainers []string
+	for _, a := range authors {
+		if float64(a.count) > maintainerShare*float64(total) {
+			maintainers = append(maintainers, a.id)
+		}
+	}
+	return maintainers
+}
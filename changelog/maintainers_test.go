@@ -0,0 +1,64 @@
+package changelog
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestMaintainersFromShortlog(t *testing.T) {
+	output := "    50\tAlice <alice@example.com>\n" +
+		"    25\tdependabot[bot] <49699333+dependabot[bot]@users.noreply.github.com>\n" +
+		"    12\tBob <bob@example.com>\n" +
+		"    10\tCarol <carol@example.com>\n" +
+		"     3\tDave\n"
+
+	cl := New("test")
+	got := cl.maintainersFromShortlog(output)
+
+	// 100 commits in total: Alice and Bob exceed 10%, Carol does not
+	want := []string{"alice@example.com", "bob@example.com"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if got := cl.maintainersFromShortlog("     3\tDave\n"); !slices.Equal(got, []string{"Dave"}) {
+		t.Errorf("expected name fallback, got %v", got)
+	}
+	if got := cl.maintainersFromShortlog(""); got != nil {
+		t.Errorf("expected no maintainers, got %v", got)
+	}
+}
+
+func TestAddMaintainerEmails(t *testing.T) {
+	cl := New("test")
+	cl.Maintainers = []string{"@janedoe", "bob"}
+
+	added := cl.AddMaintainerEmails(map[string]string{
+		"jane@corp.example.com":  "JaneDoe",
+		"other@corp.example.com": "outsider",
+	})
+	if added != 1 {
+		t.Errorf("expected 1 email added, got %d", added)
+	}
+	if !cl.IsTeamMemberByNameAndEmail("Jane Doe", "jane@corp.example.com") {
+		t.Error("expected mapped email to be a team member")
+	}
+	if cl.IsTeamMemberByNameAndEmail("Someone", "other@corp.example.com") {
+		t.Error("expected email mapped to a non-maintainer to stay external")
+	}
+
+	if added := cl.AddMaintainerEmails(map[string]string{"jane@corp.example.com": "janedoe"}); added != 0 {
+		t.Errorf("expected existing email not to be added again, got %d", added)
+	}
+}
+
+func TestInferMaintainersFromGit_NoRepo(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("GIT_CEILING_DIRECTORIES", filepath.Dir(dir))
+
+	if _, err := New("test").InferMaintainersFromGit(100); err == nil {
+		t.Error("expected error outside a git repository")
+	}
+}
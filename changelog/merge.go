@@ -0,0 +1,85 @@
+package changelog
+
+import (
+	"slices"
+	"sort"
+)
+
+// MergeRelease returns a release combining the entries of a and b. Version,
+// date, and other release metadata are taken from a (b's date is used if a has
+// none); entries from b are appended after a's entries in each category.
+// Neither input is modified.
+func MergeRelease(a, b Release) Release {
+	merged := a.Clone()
+	for _, cat := range b.Categories() {
+		field := merged.entriesField(cat.Name)
+		for _, e := range cat.Entries {
+			*field = append(*field, e.Clone())
+		}
+	}
+	if merged.Date == "" {
+		merged.Date = b.Date
+	}
+	return merged
+}
+
+// MergeChangelogs combines multiple changelogs into a single changelog with the
+// given project name, such as per-package changelogs in a monorepo. Releases
+// with the same version are merged with MergeRelease, in source order. The
+// result's releases are sorted by date descending, with ties broken by
+// descending version. Maintainers and bots are the union of all sources, and
+// the repository is taken from the first source that has one.
+func MergeChangelogs(project string, sources ...*Changelog) *Changelog {
+	out := &Changelog{
+		IRVersion: IRVersion,
+		Project:   project,
+	}
+
+	index := make(map[string]int)
+	for _, src := range sources {
+		if out.Repository == "" {
+			out.Repository = src.Repository
+		}
+		out.Maintainers = appendUnique(out.Maintainers, src.Maintainers...)
+		out.Bots = appendUnique(out.Bots, src.Bots...)
+
+		if src.Unreleased != nil {
+			if out.Unreleased == nil {
+				u := src.Unreleased.Clone()
+				out.Unreleased = &u
+			} else {
+				u := MergeRelease(*out.Unreleased, *src.Unreleased)
+				out.Unreleased = &u
+			}
+		}
+
+		for _, r := range src.Releases {
+			if i, ok := index[r.Version]; ok {
+				out.Releases[i] = MergeRelease(out.Releases[i], r)
+				continue
+			}
+			index[r.Version] = len(out.Releases)
+			out.Releases = append(out.Releases, r.Clone())
+		}
+	}
+
+	sort.SliceStable(out.Releases, func(i, j int) bool {
+		a, b := out.Releases[i], out.Releases[j]
+		if a.Date != b.Date {
+			return a.Date > b.Date
+		}
+		return CompareSemVer(a.Version, b.Version) > 0
+	})
+
+	return out
+}
+
+// appendUnique appends values to s, skipping values already present.
+func appendUnique(s []string, values ...string) []string {
+	for _, v := range values {
+		if !slices.Contains(s, v) {
+			s = append(s, v)
+		}
+	}
+	return s
+}
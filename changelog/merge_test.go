@@ -0,0 +1,101 @@
+package changelog
+
+import "testing"
+
+func TestMergeRelease(t *testing.T) {
+	a := Release{Version: "1.0.0", Date: "2026-01-01", Added: []Entry{{Description: "A feature"}}}
+	b := Release{Version: "1.0.0", Date: "2026-01-02", Added: []Entry{{Description: "B feature"}}, Fixed: []Entry{{Description: "B fix"}}}
+
+	merged := MergeRelease(a, b)
+
+	if merged.Date != "2026-01-01" {
+		t.Errorf("expected date from first release, got %q", merged.Date)
+	}
+	if len(merged.Added) != 2 || merged.Added[0].Description != "A feature" || merged.Added[1].Description != "B feature" {
+		t.Errorf("unexpected added entries: %v", merged.Added)
+	}
+	if len(merged.Fixed) != 1 {
+		t.Errorf("expected 1 fixed entry, got %d", len(merged.Fixed))
+	}
+	if len(a.Added) != 1 {
+		t.Error("MergeRelease modified its input")
+	}
+}
+
+func TestMergeChangelogs_NonOverlapping(t *testing.T) {
+	api := &Changelog{
+		IRVersion:   "1.0",
+		Project:     "api",
+		Repository:  "https://github.com/example/mono",
+		Maintainers: []string{"alice"},
+		Releases: []Release{
+			{Version: "1.1.0", Date: "2026-03-01", Added: []Entry{{Description: "API feature"}}},
+			{Version: "1.0.0", Date: "2026-01-01", Added: []Entry{{Description: "API initial"}}},
+		},
+	}
+	sdk := &Changelog{
+		IRVersion:   "1.0",
+		Project:     "sdk",
+		Maintainers: []string{"alice", "bob"},
+		Releases: []Release{
+			{Version: "0.2.0", Date: "2026-02-01", Fixed: []Entry{{Description: "SDK fix"}}},
+		},
+	}
+
+	out := MergeChangelogs("mono", api, sdk)
+
+	if out.Project != "mono" {
+		t.Errorf("expected project 'mono', got %q", out.Project)
+	}
+	if out.Repository != "https://github.com/example/mono" {
+		t.Errorf("expected repository from first source, got %q", out.Repository)
+	}
+	if len(out.Maintainers) != 2 {
+		t.Errorf("expected 2 unique maintainers, got %v", out.Maintainers)
+	}
+
+	want := []string{"1.1.0", "0.2.0", "1.0.0"}
+	if len(out.Releases) != len(want) {
+		t.Fatalf("expected %d releases, got %d", len(want), len(out.Releases))
+	}
+	for i, v := range want {
+		if out.Releases[i].Version != v {
+			t.Errorf("release %d: expected %s, got %s", i, v, out.Releases[i].Version)
+		}
+	}
+}
+
+func TestMergeChangelogs_Overlapping(t *testing.T) {
+	a := &Changelog{
+		IRVersion: "1.0",
+		Project:   "a",
+		Releases: []Release{
+			{Version: "2.0.0", Date: "2026-02-01", Added: []Entry{{Description: "A feature"}}},
+			{Version: "1.0.0", Date: "2026-01-01", Added: []Entry{{Description: "A initial"}}},
+		},
+	}
+	b := &Changelog{
+		IRVersion:  "1.0",
+		Project:    "b",
+		Unreleased: &Release{Added: []Entry{{Description: "B upcoming"}}},
+		Releases: []Release{
+			{Version: "2.0.0", Date: "2026-02-01", Fixed: []Entry{{Description: "B fix"}}},
+		},
+	}
+
+	out := MergeChangelogs("combined", a, b)
+
+	if len(out.Releases) != 2 {
+		t.Fatalf("expected 2 releases, got %d", len(out.Releases))
+	}
+	r := out.Releases[0]
+	if r.Version != "2.0.0" || len(r.Added) != 1 || len(r.Fixed) != 1 {
+		t.Errorf("expected merged 2.0.0 with added and fixed entries, got %+v", r)
+	}
+	if out.Unreleased == nil || len(out.Unreleased.Added) != 1 {
+		t.Errorf("expected unreleased from b, got %+v", out.Unreleased)
+	}
+	if len(a.Releases[0].Fixed) != 0 {
+		t.Error("MergeChangelogs modified its input")
+	}
+}
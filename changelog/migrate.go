@@ -0,0 +1,86 @@
+package changelog
+
+import "regexp"
+
+var (
+	// migratePRRegex matches a trailing PR reference like "(#123)", the
+	// convention used by GitHub squash merges.
+	migratePRRegex = regexp.MustCompile(`\(#(\d+)\)\s*$`)
+
+	// migrateIssueRegex matches issue references like "#123" or "Fixes #123".
+	migrateIssueRegex = regexp.MustCompile(`(?:^|[^\w&])#(\d+)\b`)
+
+	// migrateAuthorRegex matches "@username" mentions, excluding email addresses.
+	migrateAuthorRegex = regexp.MustCompile(`(?:^|[^\w.])(@[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?)`)
+
+	// migrateCVERegex matches CVE identifiers anywhere in the text.
+	migrateCVERegex = regexp.MustCompile(`\bCVE-\d{4}-\d{4,}\b`)
+)
+
+// MigrateFrom returns a copy of e with empty fields populated from metadata
+// found in the free-text description of old. A trailing "(#123)" sets PR,
+// other "#123" references set Issue, the first "@username" sets Author, and
+// a CVE identifier sets CVE. This is non-destructive: fields already set on
+// e, including the description, are never modified. To migrate an entry in
+// place, use e.MigrateFrom(e).
+func (e Entry) MigrateFrom(old Entry) Entry {
+	if e.Description == "" {
+		e.Description = old.Description
+	}
+	desc := old.Description
+
+	prMatch := migratePRRegex.FindStringSubmatchIndex(desc)
+	if e.PR == "" && prMatch != nil {
+		e.PR = desc[prMatch[2]:prMatch[3]]
+	}
+
+	if e.Issue == "" {
+		for _, m := range migrateIssueRegex.FindAllStringSubmatchIndex(desc, -1) {
+			// Skip the trailing PR reference
+			if prMatch != nil && m[2] >= prMatch[0] {
+				continue
+			}
+			e.Issue = desc[m[2]:m[3]]
+			break
+		}
+	}
+
+	if e.Author == "" {
+		if m := migrateAuthorRegex.FindStringSubmatch(desc); m != nil {
+			e.Author = m[1]
+		}
+	}
+
+	if e.CVE == "" {
+		e.CVE = migrateCVERegex.FindString(desc)
+	}
+
+	return e
+}
+
+// MigrateEntries applies MigrateFrom in place to every entry in the
+// changelog, including the Unreleased section, and returns the number of
+// entries that gained metadata.
+func (c *Changelog) MigrateEntries() int {
+	migrated := 0
+	migrateRelease := func(r *Release) {
+		for _, cat := range r.Categories() {
+			entries := *r.entriesField(cat.Name)
+			for i, e := range entries {
+				updated := e.MigrateFrom(e)
+				if updated.Issue != e.Issue || updated.PR != e.PR || updated.Author != e.Author || updated.CVE != e.CVE {
+					entries[i] = updated
+					migrated++
+				}
+			}
+		}
+	}
+
+	if c.Unreleased != nil {
+		migrateRelease(c.Unreleased)
+	}
+	for i := range c.Releases {
+		migrateRelease(&c.Releases[i])
+	}
+	return migrated
+}
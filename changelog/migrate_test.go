@@ -0,0 +1,109 @@
+package changelog
+
+import "testing"
+
+func TestEntryMigrateFrom(t *testing.T) {
+	tests := []struct {
+		name       string
+		desc       string
+		wantIssue  string
+		wantPR     string
+		wantAuthor string
+		wantCVE    string
+	}{
+		{
+			name:   "trailing_pr",
+			desc:   "Add streaming API (#42)",
+			wantPR: "42",
+		},
+		{
+			name:      "issue_and_pr",
+			desc:      "Fix crash on empty input, fixes #7 (#42)",
+			wantIssue: "7",
+			wantPR:    "42",
+		},
+		{
+			name:       "author",
+			desc:       "Improve docs by @alice-dev",
+			wantAuthor: "@alice-dev",
+		},
+		{
+			name:    "cve",
+			desc:    "Patch path traversal (CVE-2024-12345)",
+			wantCVE: "CVE-2024-12345",
+		},
+		{
+			name: "email_and_html_entity_ignored",
+			desc: "Contact security@example.com &#39;now&#39;",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := Entry{Description: tt.desc}
+			got := old.MigrateFrom(old)
+
+			if got.Description != tt.desc {
+				t.Errorf("Description changed to %q", got.Description)
+			}
+			if got.Issue != tt.wantIssue {
+				t.Errorf("Issue = %q, expected %q", got.Issue, tt.wantIssue)
+			}
+			if got.PR != tt.wantPR {
+				t.Errorf("PR = %q, expected %q", got.PR, tt.wantPR)
+			}
+			if got.Author != tt.wantAuthor {
+				t.Errorf("Author = %q, expected %q", got.Author, tt.wantAuthor)
+			}
+			if got.CVE != tt.wantCVE {
+				t.Errorf("CVE = %q, expected %q", got.CVE, tt.wantCVE)
+			}
+		})
+	}
+}
+
+func TestEntryMigrateFrom_NonDestructive(t *testing.T) {
+	old := Entry{Description: "Fix bug #7 by @alice (#42)"}
+	e := Entry{Description: "Fix bug", Issue: "100", Author: "@bob"}
+
+	got := e.MigrateFrom(old)
+
+	if got.Description != "Fix bug" {
+		t.Errorf("expected description to be kept, got %q", got.Description)
+	}
+	if got.Issue != "100" || got.Author != "@bob" {
+		t.Errorf("expected existing fields to be kept, got issue=%q author=%q", got.Issue, got.Author)
+	}
+	if got.PR != "42" {
+		t.Errorf("expected empty PR to be populated, got %q", got.PR)
+	}
+}
+
+func TestChangelogMigrateEntries(t *testing.T) {
+	cl := New("test-project")
+	cl.Unreleased = &Release{
+		Added: []Entry{{Description: "WIP feature (#9)"}},
+	}
+	cl.Releases = []Release{{
+		Version:  "1.0.0",
+		Date:     "2024-01-01",
+		Security: []Entry{{Description: "Fix CVE-2024-0001 reported by @mallory"}},
+		Fixed:    []Entry{{Description: "Plain fix"}},
+	}}
+
+	if n := cl.MigrateEntries(); n != 2 {
+		t.Errorf("expected 2 migrated entries, got %d", n)
+	}
+	if cl.Unreleased.Added[0].PR != "9" {
+		t.Errorf("expected unreleased PR 9, got %q", cl.Unreleased.Added[0].PR)
+	}
+	sec := cl.Releases[0].Security[0]
+	if sec.CVE != "CVE-2024-0001" || sec.Author != "@mallory" {
+		t.Errorf("unexpected security entry: %+v", sec)
+	}
+
+	// Running again is a no-op
+	if n := cl.MigrateEntries(); n != 0 {
+		t.Errorf("expected no further migrations, got %d", n)
+	}
+}
@@ -52,6 +52,15 @@ func NewNotabilityPolicy(categories []string) *NotabilityPolicy {
 	}
 }
 
+// NotabilityPolicyFromTier creates a policy whose notable categories are all
+// DefaultRegistry change types at or above the given tier, in canonical order.
+// This mirrors MaxTier filtering through the notability policy interface.
+func NotabilityPolicyFromTier(tier Tier) *NotabilityPolicy {
+	return &NotabilityPolicy{
+		NotableCategories: DefaultRegistry.NamesUpToTier(tier),
+	}
+}
+
 // IsNotable returns true if the given category is considered notable by this policy.
 func (p *NotabilityPolicy) IsNotable(categoryName string) bool {
 	if p == nil || len(p.NotableCategories) == 0 {
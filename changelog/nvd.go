@@ -0,0 +1,167 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NVD CVE JSON 1.1 format constants.
+const (
+	nvdDataType    = "CVE"
+	nvdDataFormat  = "MITRE"
+	nvdDataVersion = "4.0"
+	nvdLang        = "en"
+)
+
+// NVDItem is a CVE item in the NVD CVE JSON 1.1 feed format. Only the fields
+// that can be derived from a changelog entry are populated; configurations
+// are an empty skeleton.
+type NVDItem struct {
+	CVE              NVDCVE            `json:"cve"`
+	Configurations   NVDConfigurations `json:"configurations"`
+	Impact           NVDImpact         `json:"impact"`
+	PublishedDate    string            `json:"publishedDate,omitempty"`
+	LastModifiedDate string            `json:"lastModifiedDate,omitempty"`
+}
+
+// NVDCVE holds the CVE metadata of an NVDItem.
+type NVDCVE struct {
+	DataType    string            `json:"data_type"`
+	DataFormat  string            `json:"data_format"`
+	DataVersion string            `json:"data_version"`
+	Meta        NVDCVEMeta        `json:"CVE_data_meta"`
+	ProblemType NVDProblemType    `json:"problemtype"`
+	References  NVDReferences     `json:"references"`
+	Description NVDDescriptionSet `json:"description"`
+}
+
+// NVDCVEMeta identifies a CVE.
+type NVDCVEMeta struct {
+	ID       string `json:"ID"`
+	Assigner string `json:"ASSIGNER"`
+}
+
+// NVDProblemType lists the weaknesses (CWEs) of a CVE.
+type NVDProblemType struct {
+	Data []NVDProblemTypeData `json:"problemtype_data"`
+}
+
+// NVDProblemTypeData holds weakness identifiers such as "CWE-89".
+type NVDProblemTypeData struct {
+	Description []NVDLangString `json:"description"`
+}
+
+// NVDDescriptionSet is a list of language-tagged descriptions.
+type NVDDescriptionSet struct {
+	Data []NVDLangString `json:"description_data"`
+}
+
+// NVDLangString is a language-tagged string.
+type NVDLangString struct {
+	Lang  string `json:"lang"`
+	Value string `json:"value"`
+}
+
+// NVDReferences lists reference URLs for a CVE.
+type NVDReferences struct {
+	Data []NVDReference `json:"reference_data"`
+}
+
+// NVDReference is a reference URL for a CVE.
+type NVDReference struct {
+	URL       string   `json:"url"`
+	Name      string   `json:"name"`
+	RefSource string   `json:"refsource"`
+	Tags      []string `json:"tags"`
+}
+
+// NVDConfigurations lists the affected product configurations.
+type NVDConfigurations struct {
+	DataVersion string `json:"CVE_data_version"`
+	Nodes       []any  `json:"nodes"`
+}
+
+// NVDImpact holds CVSS metrics.
+type NVDImpact struct {
+	BaseMetricV3 *NVDBaseMetricV3 `json:"baseMetricV3,omitempty"`
+}
+
+// NVDBaseMetricV3 holds a CVSS v3 score.
+type NVDBaseMetricV3 struct {
+	CVSSV3 NVDCVSSV3 `json:"cvssV3"`
+}
+
+// NVDCVSSV3 is a CVSS v3 vector and base score.
+type NVDCVSSV3 struct {
+	Version      string  `json:"version"`
+	VectorString string  `json:"vectorString,omitempty"`
+	BaseScore    float64 `json:"baseScore"`
+	BaseSeverity string  `json:"baseSeverity,omitempty"`
+}
+
+// NVDItems returns an NVD CVE JSON 1.1 item for each Security entry with a
+// CVE, across all releases in changelog order. The release date is used as
+// the published date. The Unreleased section is not included.
+func (c *Changelog) NVDItems() []NVDItem {
+	items := []NVDItem{}
+	for _, r := range c.Releases {
+		for _, e := range r.Security {
+			if e.CVE != "" {
+				items = append(items, nvdItem(e, r.Date))
+			}
+		}
+	}
+	return items
+}
+
+// nvdItem converts a security entry released on date to an NVD item.
+func nvdItem(e Entry, date string) NVDItem {
+	item := NVDItem{
+		CVE: NVDCVE{
+			DataType:    nvdDataType,
+			DataFormat:  nvdDataFormat,
+			DataVersion: nvdDataVersion,
+			Meta:        NVDCVEMeta{ID: e.CVE},
+			ProblemType: NVDProblemType{Data: []NVDProblemTypeData{{Description: []NVDLangString{}}}},
+			References:  NVDReferences{Data: []NVDReference{}},
+			Description: NVDDescriptionSet{Data: []NVDLangString{{Lang: nvdLang, Value: e.Description}}},
+		},
+		Configurations: NVDConfigurations{DataVersion: nvdDataVersion, Nodes: []any{}},
+	}
+
+	if e.CWE != "" {
+		item.CVE.ProblemType.Data[0].Description = []NVDLangString{{Lang: nvdLang, Value: e.CWE}}
+	}
+	if e.GHSA != "" {
+		item.CVE.References.Data = append(item.CVE.References.Data, NVDReference{
+			URL:       ghsaAdvisoryBaseURL + e.GHSA,
+			Name:      e.GHSA,
+			RefSource: "GITHUB",
+			Tags:      []string{"Third Party Advisory"},
+		})
+	}
+	if e.CVSSScore != 0 || e.CVSSVector != "" {
+		item.Impact.BaseMetricV3 = &NVDBaseMetricV3{CVSSV3: NVDCVSSV3{
+			Version:      cvssVersion(e.CVSSVector),
+			VectorString: e.CVSSVector,
+			BaseScore:    e.CVSSScore,
+			BaseSeverity: strings.ToUpper(e.Severity),
+		}}
+	}
+	if date != "" {
+		item.PublishedDate = fmt.Sprintf("%sT00:00Z", date)
+		item.LastModifiedDate = item.PublishedDate
+	}
+	return item
+}
+
+// cvssVersion returns the CVSS version from a vector such as
+// "CVSS:3.1/AV:N/...", defaulting to "3.1".
+func cvssVersion(vector string) string {
+	if rest, ok := strings.CutPrefix(vector, "CVSS:"); ok {
+		if version, _, ok := strings.Cut(rest, "/"); ok {
+			return version
+		}
+	}
+	return "3.1"
+}
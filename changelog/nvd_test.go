@@ -0,0 +1,72 @@
+package changelog
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNVDItems(t *testing.T) {
+	cl := New("test")
+	cl.Unreleased = &Release{Security: []Entry{NewEntry("Unreleased fix").WithCVE("CVE-2026-00001")}}
+	cl.AddRelease(Release{
+		Version: "1.0.1",
+		Date:    "2026-01-15",
+		Security: []Entry{
+			NewEntry("Fix SQL injection").
+				WithCVE("CVE-2026-12345").
+				WithGHSA("GHSA-abcd-efgh-ijkl").
+				WithSeverity("high").
+				WithCVSS(8.1, "CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:N").
+				WithCWE("CWE-89"),
+			NewEntry("Harden headers"),
+		},
+	})
+	cl.AddRelease(Release{Version: "1.1.0", Date: "2026-02-01", Security: []Entry{NewEntry("Fix XSS").WithCVE("CVE-2026-22222")}})
+
+	items := cl.NVDItems()
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(items))
+	}
+	if items[0].CVE.Meta.ID != "CVE-2026-22222" || items[0].Impact.BaseMetricV3 != nil {
+		t.Errorf("unexpected first item: %+v", items[0])
+	}
+
+	item := items[1]
+	if item.CVE.Meta.ID != "CVE-2026-12345" || item.CVE.Description.Data[0].Value != "Fix SQL injection" {
+		t.Errorf("unexpected CVE: %+v", item.CVE)
+	}
+	if item.CVE.ProblemType.Data[0].Description[0].Value != "CWE-89" {
+		t.Errorf("unexpected problem type: %+v", item.CVE.ProblemType)
+	}
+	if refs := item.CVE.References.Data; len(refs) != 1 || refs[0].URL != "https://github.com/advisories/GHSA-abcd-efgh-ijkl" {
+		t.Errorf("unexpected references: %+v", refs)
+	}
+	cvss := item.Impact.BaseMetricV3.CVSSV3
+	if cvss.BaseScore != 8.1 || cvss.BaseSeverity != "HIGH" || cvss.Version != "3.1" {
+		t.Errorf("unexpected CVSS: %+v", cvss)
+	}
+	if item.PublishedDate != "2026-01-15T00:00Z" {
+		t.Errorf("unexpected published date %q", item.PublishedDate)
+	}
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{`"CVE_data_meta"`, `"problemtype_data"`, `"configurations":{"CVE_data_version":"4.0","nodes":[]}`, `"baseMetricV3"`} {
+		if !strings.Contains(string(data), key) {
+			t.Errorf("expected %s in JSON, got %s", key, data)
+		}
+	}
+}
+
+func TestNVDItems_Empty(t *testing.T) {
+	data, err := json.Marshal(New("test").NVDItems())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "[]" {
+		t.Errorf("expected empty JSON array, got %s", data)
+	}
+}
@@ -0,0 +1,89 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationErrors is a list of validation failures that can be returned as a
+// single error. errors.Is and errors.As match against each contained error.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i := range e {
+		msgs[i] = e[i].Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the contained errors for use with errors.Is and errors.As.
+func (e ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i := range e {
+		errs[i] = &e[i]
+	}
+	return errs
+}
+
+// ValidateOrder checks that releases are in reverse chronological order and
+// returns all violations as ValidationErrors, or nil if the order is valid.
+//
+// For calver, custom, and none versioning, releases are ordered by date.
+// For semver (the default), each release must have a higher version than the
+// one after it (ErrUnsortedReleases). Dates are also compared, and
+// ErrDateVersionMismatch is reported when date order contradicts version
+// order; this is a warning-level condition since backported patch releases
+// can legitimately be dated after newer versions.
+func (c *Changelog) ValidateOrder() error {
+	errs := c.releaseOrderErrors(ErrUnsortedReleases, ErrUnsortedReleases)
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// releaseOrderErrors compares each pair of adjacent releases as described for
+// ValidateOrder, reporting a semver release listed before a higher version as
+// versionErr and, for other versioning schemes, a release listed before an
+// older-dated one as dateErr. Date order contradicting semver order is always
+// reported as ErrDateVersionMismatch.
+func (c *Changelog) releaseOrderErrors(versionErr, dateErr error) ValidationErrors {
+	var errs ValidationErrors
+	add := func(field, message string, err error) {
+		errs = append(errs, ValidationError{Field: field, Message: message, Err: err})
+	}
+
+	semver := c.Versioning == "" || c.Versioning == VersioningSemVer
+
+	for i := 0; i+1 < len(c.Releases); i++ {
+		newer, older := &c.Releases[i], &c.Releases[i+1]
+		field := fmt.Sprintf("releases[%d]", i)
+
+		dateCmp := 0
+		if newer.Date != "" && older.Date != "" {
+			// YYYY-MM-DD dates compare correctly as strings
+			dateCmp = strings.Compare(newer.Date, older.Date)
+		}
+
+		if !semver {
+			if dateCmp < 0 {
+				add(field+".date", fmt.Sprintf("release %s (%s) is listed before older-dated release %s (%s)",
+					newer.Version, newer.Date, older.Version, older.Date), dateErr)
+			}
+			continue
+		}
+
+		versionCmp := CompareSemVer(newer.Version, older.Version)
+		if versionCmp < 0 {
+			add(field+".version", fmt.Sprintf("version %s is listed before higher version %s",
+				newer.Version, older.Version), versionErr)
+		}
+		if versionCmp != 0 && dateCmp != 0 && (versionCmp > 0) != (dateCmp > 0) {
+			add(field+".date", fmt.Sprintf("version order of %s and %s contradicts dates %s and %s",
+				newer.Version, older.Version, newer.Date, older.Date), ErrDateVersionMismatch)
+		}
+	}
+
+	return errs
+}
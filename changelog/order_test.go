@@ -0,0 +1,91 @@
+package changelog
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		versioning string
+		releases   []Release
+		wantErrs   []error
+	}{
+		{
+			name: "sorted semver",
+			releases: []Release{
+				{Version: "1.1.0", Date: "2026-02-01"},
+				{Version: "1.0.0", Date: "2026-01-01"},
+			},
+		},
+		{
+			name: "unsorted semver",
+			releases: []Release{
+				{Version: "1.0.0", Date: "2026-01-01"},
+				{Version: "1.1.0", Date: "2026-01-01"},
+			},
+			wantErrs: []error{ErrUnsortedReleases},
+		},
+		{
+			name: "unsorted semver with contradicting dates",
+			releases: []Release{
+				{Version: "1.0.0", Date: "2026-02-01"},
+				{Version: "1.1.0", Date: "2026-01-01"},
+			},
+			wantErrs: []error{ErrUnsortedReleases, ErrDateVersionMismatch},
+		},
+		{
+			name: "backport dated after newer version",
+			releases: []Release{
+				{Version: "2.0.0", Date: "2026-01-01"},
+				{Version: "1.9.1", Date: "2026-02-01"},
+			},
+			wantErrs: []error{ErrDateVersionMismatch},
+		},
+		{
+			name:       "sorted calver",
+			versioning: VersioningCalVer,
+			releases: []Release{
+				{Version: "2026.02", Date: "2026-02-01"},
+				{Version: "2026.01", Date: "2026-01-01"},
+			},
+		},
+		{
+			name:       "unsorted calver",
+			versioning: VersioningCalVer,
+			releases: []Release{
+				{Version: "2026.01", Date: "2026-01-01"},
+				{Version: "2026.02", Date: "2026-02-01"},
+			},
+			wantErrs: []error{ErrUnsortedReleases},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := &Changelog{IRVersion: "1.0", Project: "test", Versioning: tt.versioning, Releases: tt.releases}
+
+			err := cl.ValidateOrder()
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+
+			var errs ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("expected ValidationErrors, got %T: %v", err, err)
+			}
+			if len(errs) != len(tt.wantErrs) {
+				t.Fatalf("expected %d errors, got %d: %v", len(tt.wantErrs), len(errs), errs)
+			}
+			for _, want := range tt.wantErrs {
+				if !errors.Is(err, want) {
+					t.Errorf("expected error %v in %v", want, err)
+				}
+			}
+		})
+	}
+}
@@ -1,5 +1,10 @@
 package changelog
 
+import (
+	"fmt"
+	"slices"
+)
+
 // Release represents a single release in the changelog.
 type Release struct {
 	Version    string `json:"version,omitempty"`
@@ -51,6 +56,26 @@ func NewRelease(version, date string) Release {
 	}
 }
 
+// SetDate sets the release date after checking that it uses the YYYY-MM-DD
+// format. Returns ErrInvalidDate otherwise.
+func (r *Release) SetDate(date string) error {
+	if !dateRegex.MatchString(date) {
+		return fmt.Errorf("%w: %s", ErrInvalidDate, date)
+	}
+	r.Date = date
+	return nil
+}
+
+// SetVersion sets the release version after checking that it is a valid
+// semantic version, as Validate does. Returns ErrInvalidVersion otherwise.
+func (r *Release) SetVersion(version string) error {
+	if !IsValidSemVer(version) {
+		return fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+	}
+	r.Version = version
+	return nil
+}
+
 // IsEmpty returns true if the release has no entries.
 func (r *Release) IsEmpty() bool {
 	return len(r.Highlights) == 0 &&
@@ -145,11 +170,132 @@ func (r *Release) categoryMap() map[string][]Entry {
 	}
 }
 
+// entriesField returns a pointer to the entry slice for a category by name,
+// or nil if the category is not recognized.
+func (r *Release) entriesField(categoryName string) *[]Entry {
+	switch categoryName {
+	case CategoryHighlights:
+		return &r.Highlights
+	case CategoryBreaking:
+		return &r.Breaking
+	case CategoryUpgradeGuide:
+		return &r.UpgradeGuide
+	case CategorySecurity:
+		return &r.Security
+	case CategoryAdded:
+		return &r.Added
+	case CategoryChanged:
+		return &r.Changed
+	case CategoryDeprecated:
+		return &r.Deprecated
+	case CategoryRemoved:
+		return &r.Removed
+	case CategoryFixed:
+		return &r.Fixed
+	case CategoryPerformance:
+		return &r.Performance
+	case CategoryDependencies:
+		return &r.Dependencies
+	case CategoryDocumentation:
+		return &r.Documentation
+	case CategoryBuild:
+		return &r.Build
+	case CategoryTests:
+		return &r.Tests
+	case CategoryInfrastructure:
+		return &r.Infrastructure
+	case CategoryObservability:
+		return &r.Observability
+	case CategoryCompliance:
+		return &r.Compliance
+	case CategoryInternal:
+		return &r.Internal
+	case CategoryKnownIssues:
+		return &r.KnownIssues
+	case CategoryContributors:
+		return &r.Contributors
+	}
+	return nil
+}
+
+// entryFields returns pointers to all of the release's built-in category
+// entry slices, in canonical order. Unlike ranging over DefaultRegistry, it
+// always covers every field.
+func (r *Release) entryFields() []*[]Entry {
+	return []*[]Entry{
+		&r.Highlights, &r.Breaking, &r.UpgradeGuide, &r.Security,
+		&r.Added, &r.Changed, &r.Deprecated, &r.Removed, &r.Fixed,
+		&r.Performance, &r.Dependencies,
+		&r.Documentation, &r.Build, &r.Tests,
+		&r.Infrastructure, &r.Observability, &r.Compliance,
+		&r.Internal,
+		&r.KnownIssues, &r.Contributors,
+	}
+}
+
 // GetEntries returns entries for a category by name.
 func (r *Release) GetEntries(categoryName string) []Entry {
 	return r.categoryMap()[categoryName]
 }
 
+// GetEntriesAffecting returns all entries, across all categories in canonical
+// order, whose Affects list includes the given component.
+func (r *Release) GetEntriesAffecting(component string) []Entry {
+	var entries []Entry
+	for _, cat := range r.Categories() {
+		for _, e := range cat.Entries {
+			if slices.Contains(e.Affects, component) {
+				entries = append(entries, e)
+			}
+		}
+	}
+	return entries
+}
+
+// GetEntriesByComponent returns all entries, across all categories in
+// canonical order, whose Component is name.
+func (r *Release) GetEntriesByComponent(name string) []Entry {
+	var entries []Entry
+	for _, cat := range r.Categories() {
+		for _, e := range cat.Entries {
+			if e.Component == name {
+				entries = append(entries, e)
+			}
+		}
+	}
+	return entries
+}
+
+// Semver release types, as suggested from commits by
+// gitlog.ParseResult.SuggestReleaseType.
+const (
+	ReleaseTypeMajor = "major"
+	ReleaseTypeMinor = "minor"
+	ReleaseTypePatch = "patch"
+)
+
+// BreakingEntry is a breaking change entry annotated with the category it
+// was recorded in.
+type BreakingEntry struct {
+	Entry
+	SourceCategory string `json:"sourceCategory"`
+}
+
+// BreakingEntries returns all entries in the Breaking category plus entries
+// marked Breaking in any other category, in canonical category order. This is
+// useful for generating upgrade guides and warning summaries.
+func (r *Release) BreakingEntries() []BreakingEntry {
+	var entries []BreakingEntry
+	for _, cat := range r.Categories() {
+		for _, e := range cat.Entries {
+			if e.Breaking || cat.Name == CategoryBreaking {
+				entries = append(entries, BreakingEntry{Entry: e, SourceCategory: cat.Name})
+			}
+		}
+	}
+	return entries
+}
+
 // HasCategory returns true if the release has entries in the specified category.
 func (r *Release) HasCategory(categoryName string) bool {
 	entries := r.GetEntries(categoryName)
@@ -188,12 +334,72 @@ func (r *Release) IsNotable(policy *NotabilityPolicy) bool {
 	return false
 }
 
+// ReleaseSummary contains a one-line overview of a release's contents.
+type ReleaseSummary struct {
+	Version           string
+	Date              string
+	Yanked            bool
+	NotableCategories []string // Non-empty categories in DefaultNotableCategories, in canonical order
+	EntryCount        int
+	BreakingCount     int // Breaking category entries plus entries flagged Breaking
+	SecurityCount     int
+	IsMaintenanceOnly bool
+}
+
+// Summary computes a ReleaseSummary in a single pass over the categories.
+func (r *Release) Summary() ReleaseSummary {
+	s := ReleaseSummary{
+		Version: r.Version,
+		Date:    r.Date,
+		Yanked:  r.Yanked,
+	}
+
+	policy := DefaultNotabilityPolicy()
+	for _, cat := range r.Categories() {
+		s.EntryCount += len(cat.Entries)
+		if policy.IsNotable(cat.Name) {
+			s.NotableCategories = append(s.NotableCategories, cat.Name)
+		}
+		if cat.Name == CategorySecurity {
+			s.SecurityCount += len(cat.Entries)
+		}
+		for _, e := range cat.Entries {
+			if cat.Name == CategoryBreaking || e.Breaking {
+				s.BreakingCount++
+			}
+		}
+	}
+
+	// Maintenance-only categories are exactly those outside the default notable set
+	s.IsMaintenanceOnly = s.EntryCount > 0 && len(s.NotableCategories) == 0
+
+	return s
+}
+
 // Category represents a group of entries under a category heading.
 type Category struct {
 	Name    string
 	Entries []Entry
 }
 
+// ApplyEntry adds an entry to the category with the given name, such as
+// "Added" or "Known Issues". It is the dynamic equivalent of AddAdded,
+// AddFixed, and the other AddX methods, for when the category is only known
+// at runtime. Returns ErrUnknownCategory if the name is not a category in
+// DefaultRegistry with a Release field, such as a custom type.
+func (r *Release) ApplyEntry(categoryName string, e Entry) error {
+	ct := DefaultRegistry.Get(categoryName)
+	if ct == nil {
+		return fmt.Errorf("%w: %q", ErrUnknownCategory, categoryName)
+	}
+	field := r.entriesField(ct.Name)
+	if field == nil {
+		return fmt.Errorf("%w: %q", ErrUnknownCategory, categoryName)
+	}
+	*field = append(*field, e)
+	return nil
+}
+
 // AddHighlights adds an entry to the Highlights category.
 func (r *Release) AddHighlights(e Entry) {
 	r.Highlights = append(r.Highlights, e)
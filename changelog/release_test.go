@@ -1,6 +1,8 @@
 package changelog
 
 import (
+	"errors"
+	"slices"
 	"testing"
 )
 
@@ -14,6 +16,30 @@ func TestNewRelease(t *testing.T) {
 	}
 }
 
+func TestReleaseSetDateAndVersion(t *testing.T) {
+	r := NewRelease("1.0.0", "2026-01-01")
+
+	if err := r.SetDate("2026-02-03"); err != nil || r.Date != "2026-02-03" {
+		t.Errorf("SetDate: unexpected result %q, %v", r.Date, err)
+	}
+	if err := r.SetDate("Feb 3, 2026"); !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("expected ErrInvalidDate, got %v", err)
+	}
+	if r.Date != "2026-02-03" {
+		t.Errorf("expected date unchanged after error, got %q", r.Date)
+	}
+
+	if err := r.SetVersion("v1.2.0-rc.1"); err != nil || r.Version != "v1.2.0-rc.1" {
+		t.Errorf("SetVersion: unexpected result %q, %v", r.Version, err)
+	}
+	if err := r.SetVersion("1.2"); !errors.Is(err, ErrInvalidVersion) {
+		t.Errorf("expected ErrInvalidVersion, got %v", err)
+	}
+	if r.Version != "v1.2.0-rc.1" {
+		t.Errorf("expected version unchanged after error, got %q", r.Version)
+	}
+}
+
 func TestReleaseIsEmpty(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -136,6 +162,97 @@ func TestReleaseGetEntries(t *testing.T) {
 	}
 }
 
+func TestReleaseGetEntriesAffecting(t *testing.T) {
+	r := Release{
+		Added:   []Entry{{Description: "api entry", Affects: []string{"api"}}, {Description: "other"}},
+		Fixed:   []Entry{{Description: "shared fix", Affects: []string{"sdk", "api"}}},
+		Changed: []Entry{{Description: "sdk entry", Affects: []string{"sdk"}}},
+	}
+
+	entries := r.GetEntriesAffecting("api")
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Description != "api entry" || entries[1].Description != "shared fix" {
+		t.Errorf("unexpected entries order: %v", entries)
+	}
+
+	if entries := r.GetEntriesAffecting("cli"); len(entries) != 0 {
+		t.Errorf("expected 0 entries for unaffected component, got %d", len(entries))
+	}
+}
+
+func TestReleaseGetEntriesByComponent(t *testing.T) {
+	r := Release{
+		Added:   []Entry{{Description: "cli flag", Component: "cli"}, {Description: "other"}},
+		Fixed:   []Entry{{Description: "sdk fix", Component: "sdk"}},
+		Changed: []Entry{{Description: "cli output", Component: "cli"}},
+	}
+
+	entries := r.GetEntriesByComponent("cli")
+	if len(entries) != 2 || entries[0].Description != "cli flag" || entries[1].Description != "cli output" {
+		t.Errorf("unexpected entries: %v", entries)
+	}
+	if entries := r.GetEntriesByComponent("api"); len(entries) != 0 {
+		t.Errorf("expected 0 entries for unknown component, got %d", len(entries))
+	}
+}
+
+func TestReleaseBreakingEntries(t *testing.T) {
+	r := Release{
+		Breaking: []Entry{{Description: "drop v1 API"}},
+		Added:    []Entry{{Description: "new flag"}, {Description: "new config format", Breaking: true}},
+		Removed:  []Entry{{Description: "remove legacy auth", Breaking: true}},
+	}
+
+	entries := r.BreakingEntries()
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 breaking entries, got %d", len(entries))
+	}
+
+	want := []struct{ description, category string }{
+		{"drop v1 API", CategoryBreaking},
+		{"new config format", CategoryAdded},
+		{"remove legacy auth", CategoryRemoved},
+	}
+	for i, w := range want {
+		if entries[i].Description != w.description || entries[i].SourceCategory != w.category {
+			t.Errorf("entry %d: expected %q from %s, got %q from %s",
+				i, w.description, w.category, entries[i].Description, entries[i].SourceCategory)
+		}
+	}
+
+	if entries := (&Release{Fixed: []Entry{{Description: "fix"}}}).BreakingEntries(); len(entries) != 0 {
+		t.Errorf("expected no breaking entries, got %d", len(entries))
+	}
+}
+
+func TestReleaseApplyEntry(t *testing.T) {
+	r := Release{}
+
+	if err := r.ApplyEntry(CategoryKnownIssues, NewEntry("Flaky login")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(r.KnownIssues) != 1 || r.KnownIssues[0].Description != "Flaky login" {
+		t.Errorf("unexpected known issues: %+v", r.KnownIssues)
+	}
+	if err := r.ApplyEntry(CategoryFixed, NewEntry("Fix crash")); err != nil || len(r.Fixed) != 1 {
+		t.Errorf("ApplyEntry failed: err=%v, fixed=%+v", err, r.Fixed)
+	}
+	if err := r.ApplyEntry("Misc", NewEntry("x")); !errors.Is(err, ErrUnknownCategory) {
+		t.Errorf("expected ErrUnknownCategory, got %v", err)
+	}
+
+	// Custom types have no Release field
+	if err := DefaultRegistry.RegisterCustomType(ChangeType{Name: "Spike", Tier: TierOptional}); err != nil {
+		t.Fatalf("RegisterCustomType failed: %v", err)
+	}
+	defer DefaultRegistry.Unregister("Spike")
+	if err := r.ApplyEntry("Spike", NewEntry("x")); !errors.Is(err, ErrUnknownCategory) {
+		t.Errorf("expected ErrUnknownCategory for custom type, got %v", err)
+	}
+}
+
 func TestReleaseAddMethods(t *testing.T) {
 	r := Release{}
 	e := Entry{Description: "test"}
@@ -289,6 +406,56 @@ func TestReleaseCategoriesCanonicalOrder(t *testing.T) {
 	}
 }
 
+func TestReleaseSummary(t *testing.T) {
+	r := Release{
+		Version:      "2.0.0",
+		Date:         "2024-06-01",
+		Yanked:       true,
+		Breaking:     []Entry{{Description: "Drop v1 API"}},
+		Security:     []Entry{{Description: "Fix XSS"}, {Description: "Fix SSRF"}},
+		Changed:      []Entry{{Description: "Rename flag", Breaking: true}},
+		Dependencies: []Entry{{Description: "Bump deps"}},
+	}
+
+	s := r.Summary()
+
+	if s.Version != "2.0.0" || s.Date != "2024-06-01" || !s.Yanked {
+		t.Errorf("unexpected header fields: %+v", s)
+	}
+	expectedCats := []string{CategoryBreaking, CategorySecurity, CategoryChanged}
+	if !slices.Equal(s.NotableCategories, expectedCats) {
+		t.Errorf("NotableCategories = %v, expected %v", s.NotableCategories, expectedCats)
+	}
+	if s.EntryCount != 5 {
+		t.Errorf("EntryCount = %d, expected 5", s.EntryCount)
+	}
+	if s.BreakingCount != 2 {
+		t.Errorf("BreakingCount = %d, expected 2", s.BreakingCount)
+	}
+	if s.SecurityCount != 2 {
+		t.Errorf("SecurityCount = %d, expected 2", s.SecurityCount)
+	}
+	if s.IsMaintenanceOnly {
+		t.Error("expected IsMaintenanceOnly to be false")
+	}
+}
+
+func TestReleaseSummary_MaintenanceOnly(t *testing.T) {
+	tests := []Release{
+		{Version: "1.0.1", Dependencies: []Entry{{Description: "Bump deps"}}, Tests: []Entry{{Description: "More tests"}}},
+		{Version: "1.0.2"},
+		{Version: "1.1.0", Added: []Entry{{Description: "Feature"}}},
+	}
+
+	for _, r := range tests {
+		t.Run(r.Version, func(t *testing.T) {
+			if got := r.Summary().IsMaintenanceOnly; got != r.IsMaintenanceOnly() {
+				t.Errorf("Summary().IsMaintenanceOnly = %v, IsMaintenanceOnly() = %v", got, r.IsMaintenanceOnly())
+			}
+		})
+	}
+}
+
 func TestCategoryStruct(t *testing.T) {
 	cat := Category{
 		Name:    "Added",
@@ -637,6 +804,40 @@ func TestDefaultNotabilityPolicy(t *testing.T) {
 	}
 }
 
+func TestNotabilityPolicyFromTier(t *testing.T) {
+	policy := NotabilityPolicyFromTier(TierCore)
+
+	for _, cat := range []string{"Security", "Added", "Changed", "Deprecated", "Removed", "Fixed"} {
+		if !policy.IsNotable(cat) {
+			t.Errorf("expected %q to be notable at core tier", cat)
+		}
+	}
+	for _, cat := range []string{"Highlights", "Dependencies", "Internal"} {
+		if policy.IsNotable(cat) {
+			t.Errorf("expected %q to NOT be notable at core tier", cat)
+		}
+	}
+
+	standard := NotabilityPolicyFromTier(TierStandard)
+	tests := []struct {
+		name    string
+		release Release
+		want    bool
+	}{
+		{"core entry", Release{Fixed: []Entry{{Description: "fix"}}}, true},
+		{"standard entry", Release{Performance: []Entry{{Description: "faster"}}}, true},
+		{"extended entry only", Release{Documentation: []Entry{{Description: "docs"}}}, false},
+		{"optional entry only", Release{Internal: []Entry{{Description: "refactor"}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.release.IsNotable(standard); got != tt.want {
+				t.Errorf("IsNotable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNotabilityPolicy_IsNotable(t *testing.T) {
 	tests := []struct {
 		name     string
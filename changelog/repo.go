@@ -0,0 +1,134 @@
+package changelog
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// githubRepoURLPattern matches GitHub repository URLs and extracts owner/repo.
+var githubRepoURLPattern = regexp.MustCompile(`^https?://github\.com/([^/]+)/([^/]+?)(?:\.git)?/?$`)
+
+// gitlabRepoURLPattern matches GitLab repository URLs and extracts the full
+// project path, which may include nested groups.
+var gitlabRepoURLPattern = regexp.MustCompile(`^https?://gitlab\.com/(.+?)(?:\.git)?/?$`)
+
+// RepoHost identifies a supported repository hosting service.
+type RepoHost int
+
+const (
+	RepoHostUnknown RepoHost = iota
+	RepoHostGitHub
+	RepoHostGitLab
+)
+
+// RepoURL is a parsed GitHub or GitLab repository URL, used to build links to
+// tags, comparisons, issues, pull requests, and commits.
+type RepoURL struct {
+	// BaseURL is the canonical repository URL, e.g.
+	// "https://github.com/owner/repo", without a trailing ".git" or "/".
+	BaseURL string
+
+	// Host is the hosting service, or RepoHostUnknown if the URL is not a
+	// supported repository URL.
+	Host RepoHost
+
+	// Path is the repository path on the host, e.g. "owner/repo" or, for
+	// GitLab nested groups, "group/subgroup/repo".
+	Path string
+}
+
+// ParseRepoURL parses a GitHub or GitLab repository URL. For any other URL
+// the returned RepoURL has Host RepoHostUnknown and no BaseURL.
+func ParseRepoURL(repoURL string) RepoURL {
+	if m := githubRepoURLPattern.FindStringSubmatch(repoURL); m != nil {
+		path := m[1] + "/" + m[2]
+		return RepoURL{BaseURL: "https://github.com/" + path, Host: RepoHostGitHub, Path: path}
+	}
+	if m := gitlabRepoURLPattern.FindStringSubmatch(repoURL); m != nil {
+		return RepoURL{BaseURL: "https://gitlab.com/" + m[1], Host: RepoHostGitLab, Path: m[1]}
+	}
+	return RepoURL{}
+}
+
+// Known reports whether the repository host is supported.
+func (r RepoURL) Known() bool {
+	return r.Host != RepoHostUnknown
+}
+
+// CompareURL returns the URL comparing two versions. Versions are used as-is
+// and prefixed with tagPath; see VersionTag.
+func (r RepoURL) CompareURL(tagPath, fromVersion, toVersion string) string {
+	fromTag := VersionTag(tagPath, fromVersion)
+	toTag := VersionTag(tagPath, toVersion)
+	if r.Host == RepoHostGitLab {
+		return fmt.Sprintf("%s/-/compare/%s...%s", r.BaseURL, fromTag, toTag)
+	}
+	return fmt.Sprintf("%s/compare/%s...%s", r.BaseURL, fromTag, toTag)
+}
+
+// TagURL returns the release URL for a version, prefixed with tagPath; see
+// VersionTag.
+func (r RepoURL) TagURL(tagPath, version string) string {
+	tag := VersionTag(tagPath, version)
+	if r.Host == RepoHostGitLab {
+		return fmt.Sprintf("%s/-/releases/%s", r.BaseURL, tag)
+	}
+	return fmt.Sprintf("%s/releases/tag/%s", r.BaseURL, tag)
+}
+
+// IssueURL returns the URL of an issue number.
+func (r RepoURL) IssueURL(num string) string {
+	if r.Host == RepoHostGitLab {
+		return fmt.Sprintf("%s/-/issues/%s", r.BaseURL, num)
+	}
+	return fmt.Sprintf("%s/issues/%s", r.BaseURL, num)
+}
+
+// PRURL returns the URL of a pull request (GitLab: merge request) number.
+func (r RepoURL) PRURL(num string) string {
+	if r.Host == RepoHostGitLab {
+		return fmt.Sprintf("%s/-/merge_requests/%s", r.BaseURL, num)
+	}
+	return fmt.Sprintf("%s/pull/%s", r.BaseURL, num)
+}
+
+// CommitURL returns the URL of a commit. Commit hashes are repository-wide,
+// so no tag path applies.
+func (r RepoURL) CommitURL(sha string) string {
+	if r.Host == RepoHostGitLab {
+		return fmt.Sprintf("%s/-/commit/%s", r.BaseURL, sha)
+	}
+	return fmt.Sprintf("%s/commit/%s", r.BaseURL, sha)
+}
+
+// VersionTag returns the git tag for a version with an optional tag path
+// prefix: with tagPath "sdk/go", "v1.0.0" becomes "sdk/go/v1.0.0". "HEAD" is
+// never prefixed as it is a git ref, not a version tag.
+func VersionTag(tagPath, version string) string {
+	if tagPath == "" || version == "HEAD" {
+		return version
+	}
+	return strings.TrimSuffix(tagPath, "/") + "/" + version
+}
+
+// VersionPrefixStrip is the version prefix that strips a leading "v" from
+// versions; see PrefixVersion.
+const VersionPrefixStrip = "-"
+
+// PrefixVersion adjusts a stored version for display and tag links. An empty
+// prefix leaves the version unchanged, VersionPrefixStrip strips a leading
+// "v", and any other prefix is prepended unless the version already starts
+// with it.
+func PrefixVersion(version, prefix string) string {
+	switch {
+	case prefix == "":
+		return version
+	case prefix == VersionPrefixStrip:
+		return strings.TrimPrefix(version, "v")
+	case strings.HasPrefix(version, prefix):
+		return version
+	default:
+		return prefix + version
+	}
+}
@@ -0,0 +1,48 @@
+package changelog
+
+import "testing"
+
+func TestParseRepoURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want RepoURL
+	}{
+		{"https://github.com/owner/repo.git", RepoURL{BaseURL: "https://github.com/owner/repo", Host: RepoHostGitHub, Path: "owner/repo"}},
+		{"https://github.com/owner/repo/", RepoURL{BaseURL: "https://github.com/owner/repo", Host: RepoHostGitHub, Path: "owner/repo"}},
+		{"https://gitlab.com/group/sub/repo", RepoURL{BaseURL: "https://gitlab.com/group/sub/repo", Host: RepoHostGitLab, Path: "group/sub/repo"}},
+		{"https://bitbucket.org/owner/repo", RepoURL{}},
+		{"", RepoURL{}},
+	}
+
+	for _, tt := range tests {
+		if got := ParseRepoURL(tt.url); got != tt.want {
+			t.Errorf("ParseRepoURL(%q) = %+v, want %+v", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestRepoURLLinks(t *testing.T) {
+	gh := ParseRepoURL("https://github.com/owner/repo")
+	gl := ParseRepoURL("https://gitlab.com/owner/repo")
+
+	tests := []struct {
+		got, want string
+	}{
+		{gh.CompareURL("sdk/go", "v1.0.0", "HEAD"), "https://github.com/owner/repo/compare/sdk/go/v1.0.0...HEAD"},
+		{gh.TagURL("", "v1.0.0"), "https://github.com/owner/repo/releases/tag/v1.0.0"},
+		{gh.IssueURL("12"), "https://github.com/owner/repo/issues/12"},
+		{gh.PRURL("34"), "https://github.com/owner/repo/pull/34"},
+		{gh.CommitURL("abc123"), "https://github.com/owner/repo/commit/abc123"},
+		{gl.CompareURL("", "v1.0.0", "v1.1.0"), "https://gitlab.com/owner/repo/-/compare/v1.0.0...v1.1.0"},
+		{gl.TagURL("", "v1.0.0"), "https://gitlab.com/owner/repo/-/releases/v1.0.0"},
+		{gl.IssueURL("12"), "https://gitlab.com/owner/repo/-/issues/12"},
+		{gl.PRURL("34"), "https://gitlab.com/owner/repo/-/merge_requests/34"},
+		{gl.CommitURL("abc123"), "https://gitlab.com/owner/repo/-/commit/abc123"},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("got %q, want %q", tt.got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package changelog
+
+import (
+	"strconv"
+	"strings"
+)
+
+// CompareSemVer compares two semantic version strings.
+// Returns -1 if a < b, 0 if a == b, and 1 if a > b.
+// A leading "v" is ignored and build metadata does not affect precedence.
+// Pre-release versions have lower precedence than the associated normal
+// version (e.g., 1.0.0-beta < 1.0.0). If either version is not valid
+// SemVer, the strings are compared lexically.
+func CompareSemVer(a, b string) int {
+	am := semverRegex.FindStringSubmatch(a)
+	bm := semverRegex.FindStringSubmatch(b)
+	if am == nil || bm == nil {
+		return strings.Compare(a, b)
+	}
+
+	// Compare MAJOR.MINOR.PATCH
+	for i := 1; i <= 3; i++ {
+		if c := compareNumeric(am[i], bm[i]); c != 0 {
+			return c
+		}
+	}
+
+	return comparePrerelease(am[4], bm[4])
+}
+
+// compareNumeric compares two non-negative integer strings.
+func compareNumeric(a, b string) int {
+	an, _ := strconv.Atoi(a)
+	bn, _ := strconv.Atoi(b)
+	switch {
+	case an < bn:
+		return -1
+	case an > bn:
+		return 1
+	}
+	return 0
+}
+
+// comparePrerelease compares pre-release identifiers per SemVer 2.0.0 §11.
+func comparePrerelease(a, b string) int {
+	// A version without a pre-release has higher precedence
+	switch {
+	case a == "" && b == "":
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aNum, aErr := strconv.Atoi(aParts[i])
+		bNum, bErr := strconv.Atoi(bParts[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if aNum != bNum {
+				if aNum < bNum {
+					return -1
+				}
+				return 1
+			}
+		case aErr == nil:
+			return -1 // Numeric identifiers have lower precedence
+		case bErr == nil:
+			return 1
+		default:
+			if c := strings.Compare(aParts[i], bParts[i]); c != 0 {
+				return c
+			}
+		}
+	}
+
+	switch {
+	case len(aParts) < len(bParts):
+		return -1
+	case len(aParts) > len(bParts):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,32 @@
+package changelog
+
+import "testing"
+
+func TestCompareSemVer(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"v1.0.0", "1.0.0", 0},
+		{"1.0.0", "1.0.1", -1},
+		{"1.1.0", "1.0.9", 1},
+		{"2.0.0", "10.0.0", -1},
+		{"1.0.0-beta", "1.0.0", -1},
+		{"1.0.0", "1.0.0-rc.1", 1},
+		{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-rc.1", "1.0.0-beta.11", 1},
+		{"1.0.0+build.1", "1.0.0+build.2", 0},
+		{"invalid", "1.0.0", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_vs_"+tt.b, func(t *testing.T) {
+			if got := CompareSemVer(tt.a, tt.b); got != tt.expected {
+				t.Errorf("CompareSemVer(%q, %q) = %d, expected %d", tt.a, tt.b, got, tt.expected)
+			}
+		})
+	}
+}
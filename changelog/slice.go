@@ -0,0 +1,38 @@
+package changelog
+
+import "fmt"
+
+// Slice returns a new Changelog containing only releases from from through to
+// (inclusive, semver order). An empty from starts at the oldest release and an
+// empty to ends at the newest. The Unreleased section is included only when
+// to is empty. The receiver is not modified.
+func (c *Changelog) Slice(from, to string) (*Changelog, error) {
+	if from != "" && c.FindRelease(from) == nil {
+		return nil, fmt.Errorf("%w: %s", ErrVersionNotFound, from)
+	}
+	if to != "" && c.FindRelease(to) == nil {
+		return nil, fmt.Errorf("%w: %s", ErrVersionNotFound, to)
+	}
+	if from != "" && to != "" && CompareSemVer(from, to) > 0 {
+		return nil, fmt.Errorf("%w: %s is greater than %s", ErrInvalidRange, from, to)
+	}
+
+	out := c.Clone()
+	releases := out.Releases
+	out.Releases = nil
+	if to != "" {
+		out.Unreleased = nil
+	}
+
+	for _, r := range releases {
+		if from != "" && CompareSemVer(r.Version, from) < 0 {
+			continue
+		}
+		if to != "" && CompareSemVer(r.Version, to) > 0 {
+			continue
+		}
+		out.Releases = append(out.Releases, r)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,85 @@
+package changelog
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSlice(t *testing.T) {
+	tests := []struct {
+		name           string
+		from           string
+		to             string
+		wantVersions   []string
+		wantUnreleased bool
+	}{
+		{"bounded", "1.0.1", "1.1.0", []string{"1.1.0", "1.0.1"}, false},
+		{"open_start", "", "1.0.1", []string{"1.0.1", "1.0.0"}, false},
+		{"open_end", "1.1.0", "", []string{"1.2.0", "1.1.0"}, true},
+		{"all", "", "", []string{"1.2.0", "1.1.0", "1.0.1", "1.0.0"}, true},
+		{"single", "1.1.0", "1.1.0", []string{"1.1.0"}, false},
+	}
+
+	cl := &Changelog{
+		IRVersion:  "1.0",
+		Project:    "test",
+		Unreleased: &Release{Added: []Entry{{Description: "Upcoming"}}},
+		Releases: []Release{
+			{Version: "1.2.0", Date: "2026-04-01"},
+			{Version: "1.1.0", Date: "2026-03-01"},
+			{Version: "1.0.1", Date: "2026-02-01"},
+			{Version: "1.0.0", Date: "2026-01-01"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := cl.Slice(tt.from, tt.to)
+			if err != nil {
+				t.Fatalf("Slice failed: %v", err)
+			}
+
+			var got []string
+			for _, r := range out.Releases {
+				got = append(got, r.Version)
+			}
+			if len(got) != len(tt.wantVersions) {
+				t.Fatalf("expected versions %v, got %v", tt.wantVersions, got)
+			}
+			for i := range got {
+				if got[i] != tt.wantVersions[i] {
+					t.Errorf("expected versions %v, got %v", tt.wantVersions, got)
+					break
+				}
+			}
+
+			if (out.Unreleased != nil) != tt.wantUnreleased {
+				t.Errorf("expected unreleased present = %v", tt.wantUnreleased)
+			}
+			if len(cl.Releases) != 4 || cl.Unreleased == nil {
+				t.Error("Slice modified the receiver")
+			}
+		})
+	}
+}
+
+func TestSlice_Errors(t *testing.T) {
+	cl := &Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []Release{
+			{Version: "1.1.0", Date: "2026-03-01"},
+			{Version: "1.0.0", Date: "2026-01-01"},
+		},
+	}
+
+	if _, err := cl.Slice("1.1.0", "1.0.0"); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("expected ErrInvalidRange, got %v", err)
+	}
+	if _, err := cl.Slice("0.9.0", ""); !errors.Is(err, ErrVersionNotFound) {
+		t.Errorf("expected ErrVersionNotFound for from, got %v", err)
+	}
+	if _, err := cl.Slice("", "2.0.0"); !errors.Is(err, ErrVersionNotFound) {
+		t.Errorf("expected ErrVersionNotFound for to, got %v", err)
+	}
+}
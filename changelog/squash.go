@@ -0,0 +1,73 @@
+package changelog
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidRange is returned when a version range's lower bound is greater than its upper bound.
+var ErrInvalidRange = errors.New("invalid version range")
+
+// Squash combines all releases from fromVersion through toVersion (inclusive,
+// semver order) into a single release with newVersion and newDate. Category
+// entries are concatenated in changelog order, dropping entries whose
+// description duplicates (case-insensitively) an earlier one in the same
+// category. The squashed release takes the position of the newest release in
+// the range. A new Changelog is returned; the receiver is not modified.
+func (c *Changelog) Squash(fromVersion, toVersion, newVersion, newDate string) (*Changelog, error) {
+	if c.FindRelease(fromVersion) == nil {
+		return nil, fmt.Errorf("%w: %s", ErrVersionNotFound, fromVersion)
+	}
+	if c.FindRelease(toVersion) == nil {
+		return nil, fmt.Errorf("%w: %s", ErrVersionNotFound, toVersion)
+	}
+	if CompareSemVer(fromVersion, toVersion) > 0 {
+		return nil, fmt.Errorf("%w: %s is greater than %s", ErrInvalidRange, fromVersion, toVersion)
+	}
+
+	squashed := NewRelease(newVersion, newDate)
+	seen := make(map[string]map[string]bool)
+
+	out := c.Clone()
+	out.Releases = make([]Release, 0, len(c.Releases))
+	insertAt := -1
+
+	for i := range c.Releases {
+		r := &c.Releases[i]
+		if CompareSemVer(r.Version, fromVersion) < 0 || CompareSemVer(r.Version, toVersion) > 0 {
+			out.Releases = append(out.Releases, r.Clone())
+			continue
+		}
+
+		if insertAt < 0 {
+			// Reserve the slot; filled in once all entries are collected.
+			insertAt = len(out.Releases)
+			out.Releases = append(out.Releases, Release{})
+		}
+		squashInto(&squashed, r, seen)
+	}
+
+	out.Releases[insertAt] = squashed
+
+	return out, nil
+}
+
+// squashInto appends the entries of src to dst by category, skipping
+// descriptions already seen in that category.
+func squashInto(dst, src *Release, seen map[string]map[string]bool) {
+	for _, cat := range src.Categories() {
+		field := dst.entriesField(cat.Name)
+		if seen[cat.Name] == nil {
+			seen[cat.Name] = make(map[string]bool)
+		}
+		for _, e := range cat.Entries {
+			key := strings.ToLower(strings.TrimSpace(e.Description))
+			if seen[cat.Name][key] {
+				continue
+			}
+			seen[cat.Name][key] = true
+			*field = append(*field, e.Clone())
+		}
+	}
+}
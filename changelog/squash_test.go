@@ -0,0 +1,78 @@
+package changelog
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSquash(t *testing.T) {
+	cl := &Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []Release{
+			{Version: "1.1.0", Date: "2026-03-01", Added: []Entry{{Description: "Feature C"}}},
+			{Version: "1.0.2", Date: "2026-02-15", Fixed: []Entry{{Description: "Fix B"}, {Description: "fix a"}}},
+			{Version: "1.0.1", Date: "2026-02-01", Fixed: []Entry{{Description: "Fix A"}}, Security: []Entry{{Description: "Patch CVE"}}},
+			{Version: "1.0.0", Date: "2026-01-01", Added: []Entry{{Description: "Initial"}}},
+		},
+	}
+
+	out, err := cl.Squash("1.0.1", "1.0.2", "1.0.2", "2026-02-15")
+	if err != nil {
+		t.Fatalf("Squash failed: %v", err)
+	}
+
+	if len(out.Releases) != 3 {
+		t.Fatalf("expected 3 releases, got %d", len(out.Releases))
+	}
+	versions := []string{out.Releases[0].Version, out.Releases[1].Version, out.Releases[2].Version}
+	if versions[0] != "1.1.0" || versions[1] != "1.0.2" || versions[2] != "1.0.0" {
+		t.Errorf("unexpected release order: %v", versions)
+	}
+
+	squashed := out.Releases[1]
+	if squashed.Date != "2026-02-15" {
+		t.Errorf("expected date 2026-02-15, got %q", squashed.Date)
+	}
+	// "fix a" and "Fix A" are duplicates; the first (newest) is kept
+	if len(squashed.Fixed) != 2 {
+		t.Fatalf("expected 2 fixed entries after de-duplication, got %d: %v", len(squashed.Fixed), squashed.Fixed)
+	}
+	if squashed.Fixed[0].Description != "Fix B" || squashed.Fixed[1].Description != "fix a" {
+		t.Errorf("unexpected fixed entries: %v", squashed.Fixed)
+	}
+	if len(squashed.Security) != 1 {
+		t.Errorf("expected 1 security entry, got %d", len(squashed.Security))
+	}
+
+	// Receiver is not modified
+	if len(cl.Releases) != 4 || len(cl.Releases[1].Fixed) != 2 {
+		t.Error("Squash modified the receiver")
+	}
+}
+
+func TestSquash_InvalidRange(t *testing.T) {
+	cl := &Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases:  []Release{{Version: "1.0.2", Date: "2026-02-15"}, {Version: "1.0.1", Date: "2026-02-01"}},
+	}
+
+	_, err := cl.Squash("1.0.2", "1.0.1", "1.0.2", "2026-02-15")
+	if !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("expected ErrInvalidRange, got %v", err)
+	}
+}
+
+func TestSquash_VersionNotFound(t *testing.T) {
+	cl := &Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases:  []Release{{Version: "1.0.2", Date: "2026-02-15"}, {Version: "1.0.1", Date: "2026-02-01"}},
+	}
+
+	_, err := cl.Squash("0.9.0", "1.0.2", "1.0.2", "2026-02-15")
+	if !errors.Is(err, ErrVersionNotFound) {
+		t.Errorf("expected ErrVersionNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+package changelog
+
+import (
+	"sort"
+)
+
+// CategoryCount holds the number of entries in a category.
+type CategoryCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// Statistics contains aggregate analytics about a changelog's releases.
+// The Unreleased section is not included.
+type Statistics struct {
+	TotalReleases            int             `json:"totalReleases"`
+	TotalEntries             int             `json:"totalEntries"`
+	FirstReleaseDate         string          `json:"firstReleaseDate,omitempty"`
+	LatestReleaseDate        string          `json:"latestReleaseDate,omitempty"`
+	Categories               []CategoryCount `json:"categories,omitempty"`
+	AverageEntriesPerRelease float64         `json:"averageEntriesPerRelease"`
+	SecurityIssues           int             `json:"securityIssues"`
+	BreakingChanges          int             `json:"breakingChanges"`
+	ExternalContributors     int             `json:"externalContributors"`
+	NotableReleases          int             `json:"notableReleases"`
+	MaintenanceReleases      int             `json:"maintenanceReleases"`
+	MaintenanceRatio         float64         `json:"maintenanceRatio"`
+}
+
+// ComputeStatistics computes analytics across all releases in the changelog.
+// Categories are sorted by entry count (descending), then by canonical order.
+// Breaking changes count entries in the Breaking category plus entries flagged
+// as breaking in other categories. External contributors are unique entry
+// authors who are not maintainers or known bots.
+func (c *Changelog) ComputeStatistics() Statistics {
+	s := Statistics{
+		TotalReleases: len(c.Releases),
+	}
+
+	categoryCounts := make(map[string]int)
+	externalAuthors := make(map[string]bool)
+
+	for i := range c.Releases {
+		r := &c.Releases[i]
+
+		// Date range (releases are reverse chronological, but don't rely on it)
+		if r.Date != "" {
+			if s.FirstReleaseDate == "" || r.Date < s.FirstReleaseDate {
+				s.FirstReleaseDate = r.Date
+			}
+			if r.Date > s.LatestReleaseDate {
+				s.LatestReleaseDate = r.Date
+			}
+		}
+
+		if r.IsMaintenanceOnly() {
+			s.MaintenanceReleases++
+		} else if !r.IsEmpty() {
+			s.NotableReleases++
+		}
+
+		for _, cat := range r.Categories() {
+			categoryCounts[cat.Name] += len(cat.Entries)
+			s.TotalEntries += len(cat.Entries)
+
+			for _, e := range cat.Entries {
+				if cat.Name == CategoryBreaking || e.Breaking {
+					s.BreakingChanges++
+				}
+				if cat.Name == CategorySecurity {
+					s.SecurityIssues++
+				}
+				if e.Author != "" && !c.IsTeamMember(e.Author) {
+					externalAuthors[normalizeAuthor(e.Author)] = true
+				}
+			}
+		}
+	}
+
+	s.ExternalContributors = len(externalAuthors)
+
+	if s.TotalReleases > 0 {
+		s.AverageEntriesPerRelease = float64(s.TotalEntries) / float64(s.TotalReleases)
+		s.MaintenanceRatio = float64(s.MaintenanceReleases) / float64(s.TotalReleases)
+	}
+
+	// Build category counts in canonical order, then stable-sort by count
+	for _, name := range DefaultRegistry.Names() {
+		if n := categoryCounts[name]; n > 0 {
+			s.Categories = append(s.Categories, CategoryCount{Name: name, Count: n})
+		}
+	}
+	sort.SliceStable(s.Categories, func(i, j int) bool {
+		return s.Categories[i].Count > s.Categories[j].Count
+	})
+
+	return s
+}
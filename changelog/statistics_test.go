@@ -0,0 +1,72 @@
+package changelog
+
+import "testing"
+
+func TestComputeStatistics(t *testing.T) {
+	cl := &Changelog{
+		IRVersion:   IRVersion,
+		Project:     "test",
+		Maintainers: []string{"alice"},
+		Releases: []Release{
+			{
+				Version:  "1.2.0",
+				Date:     "2026-03-01",
+				Added:    []Entry{{Description: "Feature A", Author: "bob"}, {Description: "Feature B", Breaking: true}},
+				Security: []Entry{{Description: "Fix CVE", CVE: "CVE-2026-12345"}},
+			},
+			{
+				Version:      "1.1.1",
+				Date:         "2026-02-01",
+				Dependencies: []Entry{{Description: "Bump deps", Author: "dependabot[bot]"}},
+			},
+			{
+				Version:  "1.1.0",
+				Date:     "2026-01-15",
+				Added:    []Entry{{Description: "Feature C", Author: "alice"}},
+				Breaking: []Entry{{Description: "Remove old API"}},
+				Fixed:    []Entry{{Description: "Fix bug", Author: "@Bob"}, {Description: "Fix other", Author: "carol"}},
+			},
+		},
+	}
+
+	s := cl.ComputeStatistics()
+
+	if s.TotalReleases != 3 {
+		t.Errorf("expected 3 releases, got %d", s.TotalReleases)
+	}
+	if s.TotalEntries != 8 {
+		t.Errorf("expected 8 entries, got %d", s.TotalEntries)
+	}
+	if s.FirstReleaseDate != "2026-01-15" || s.LatestReleaseDate != "2026-03-01" {
+		t.Errorf("unexpected date range %s - %s", s.FirstReleaseDate, s.LatestReleaseDate)
+	}
+	if len(s.Categories) == 0 || s.Categories[0].Name != CategoryAdded || s.Categories[0].Count != 3 {
+		t.Errorf("expected Added (3) as most common category, got %+v", s.Categories)
+	}
+	if s.SecurityIssues != 1 {
+		t.Errorf("expected 1 security issue, got %d", s.SecurityIssues)
+	}
+	if s.BreakingChanges != 2 {
+		t.Errorf("expected 2 breaking changes, got %d", s.BreakingChanges)
+	}
+	if s.ExternalContributors != 2 {
+		t.Errorf("expected 2 external contributors (bob, carol), got %d", s.ExternalContributors)
+	}
+	if s.NotableReleases != 2 || s.MaintenanceReleases != 1 {
+		t.Errorf("expected 2 notable and 1 maintenance, got %d and %d", s.NotableReleases, s.MaintenanceReleases)
+	}
+	if s.AverageEntriesPerRelease < 2.66 || s.AverageEntriesPerRelease > 2.67 {
+		t.Errorf("expected average ~2.67, got %f", s.AverageEntriesPerRelease)
+	}
+	if s.MaintenanceRatio < 0.33 || s.MaintenanceRatio > 0.34 {
+		t.Errorf("expected maintenance ratio ~0.33, got %f", s.MaintenanceRatio)
+	}
+}
+
+func TestComputeStatistics_Empty(t *testing.T) {
+	s := New("test").ComputeStatistics()
+
+	if s.TotalReleases != 0 || s.AverageEntriesPerRelease != 0 || s.MaintenanceRatio != 0 {
+		t.Errorf("expected zero statistics, got %+v", s)
+	}
+}
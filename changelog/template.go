@@ -0,0 +1,77 @@
+package changelog
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// ApplyTemplate evaluates tmpl as a Go text/template with the changelog as
+// the data root, as an escape hatch from the built-in renderers. In addition
+// to the standard template functions, the following helpers are available:
+//
+//	releaseURL VERSION        tag URL for a version (GitHub/GitLab Repository)
+//	compareURL FROM TO        URL comparing two versions
+//	formatDate LAYOUT DATE    re-format a YYYY-MM-DD date with a Go time layout
+//	categoryTier NAME         tier of a category, e.g. "core"
+//	truncate N S              first N characters of S, with "..." if cut
+//	joinStrings SEP ELEMS     strings.Join with the separator first
+//
+// Argument order puts the value last so helpers work in pipelines, e.g.
+// {{ .Description | truncate 40 }}. URL helpers return "" when Repository
+// is not a supported host.
+func (c *Changelog) ApplyTemplate(tmpl string) (string, error) {
+	t, err := template.New("changelog").Funcs(c.templateFuncs()).Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var sb strings.Builder
+	if err := t.Execute(&sb, c); err != nil {
+		return "", fmt.Errorf("failed to execute template: %w", err)
+	}
+	return sb.String(), nil
+}
+
+// templateFuncs returns the helper functions available to ApplyTemplate.
+func (c *Changelog) templateFuncs() template.FuncMap {
+	repo := ParseRepoURL(c.Repository)
+	return template.FuncMap{
+		"releaseURL": func(version string) string {
+			if !repo.Known() {
+				return ""
+			}
+			return repo.TagURL(c.TagPath, version)
+		},
+		"compareURL": func(from, to string) string {
+			if !repo.Known() {
+				return ""
+			}
+			return repo.CompareURL(c.TagPath, from, to)
+		},
+		"formatDate": func(layout, date string) string {
+			t, err := time.Parse("2006-01-02", date)
+			if err != nil {
+				return date
+			}
+			return t.Format(layout)
+		},
+		"categoryTier": func(name string) string {
+			if ct := DefaultRegistry.Get(name); ct != nil {
+				return string(ct.Tier)
+			}
+			return ""
+		},
+		"truncate": func(n int, s string) string {
+			runes := []rune(s)
+			if n < 0 || len(runes) <= n {
+				return s
+			}
+			return string(runes[:n]) + "..."
+		},
+		"joinStrings": func(sep string, elems []string) string {
+			return strings.Join(elems, sep)
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package changelog
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApplyTemplate(t *testing.T) {
+	cl := &Changelog{
+		IRVersion:  IRVersion,
+		Project:    "demo",
+		Repository: "https://github.com/example/demo",
+		Releases: []Release{
+			{
+				Version: "v1.1.0",
+				Date:    "2024-02-01",
+				Added:   []Entry{{Description: "Add a configurable retry policy", Affects: []string{"api", "sdk"}}},
+			},
+			{Version: "v1.0.0", Date: "2024-01-15"},
+		},
+	}
+
+	tmpl := `{{ .Project }}
+{{ range .Releases }}{{ .Version }} {{ formatDate "January 2, 2006" .Date }} {{ releaseURL .Version }}
+{{ end }}{{ compareURL "v1.0.0" "v1.1.0" }}
+{{ with index .Releases 0 }}{{ range .Added }}{{ .Description | truncate 15 }} [{{ .Affects | joinStrings ", " }}]{{ end }}{{ end }}
+{{ categoryTier "Added" }} {{ categoryTier "Internal" }} [{{ categoryTier "Unknown" }}]`
+
+	got, err := cl.ApplyTemplate(tmpl)
+	if err != nil {
+		t.Fatalf("ApplyTemplate failed: %v", err)
+	}
+
+	want := `demo
+v1.1.0 February 1, 2024 https://github.com/example/demo/releases/tag/v1.1.0
+v1.0.0 January 15, 2024 https://github.com/example/demo/releases/tag/v1.0.0
+https://github.com/example/demo/compare/v1.0.0...v1.1.0
+Add a configura... [api, sdk]
+core optional []`
+	if got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestApplyTemplate_Helpers(t *testing.T) {
+	cl := &Changelog{IRVersion: IRVersion, Project: "demo", TagPath: "sdk/go"}
+
+	tests := []struct {
+		tmpl string
+		want string
+	}{
+		{`{{ releaseURL "v1.0.0" }}`, ""},
+		{`{{ formatDate "02/01/2006" "Q1 2024" }}`, "Q1 2024"},
+		{`{{ truncate 10 "short" }}`, "short"},
+	}
+
+	for _, tt := range tests {
+		got, err := cl.ApplyTemplate(tt.tmpl)
+		if err != nil {
+			t.Fatalf("ApplyTemplate(%q) failed: %v", tt.tmpl, err)
+		}
+		if got != tt.want {
+			t.Errorf("ApplyTemplate(%q) = %q, expected %q", tt.tmpl, got, tt.want)
+		}
+	}
+
+	cl.Repository = "https://gitlab.com/group/sub/demo"
+	got, err := cl.ApplyTemplate(`{{ compareURL "v1.0.0" "v1.1.0" }}`)
+	if err != nil {
+		t.Fatalf("ApplyTemplate failed: %v", err)
+	}
+	if got != "https://gitlab.com/group/sub/demo/-/compare/sdk/go/v1.0.0...sdk/go/v1.1.0" {
+		t.Errorf("unexpected GitLab compare URL with tag path: %s", got)
+	}
+}
+
+func TestApplyTemplate_Errors(t *testing.T) {
+	cl := &Changelog{IRVersion: IRVersion, Project: "demo"}
+
+	tests := []struct {
+		name    string
+		tmpl    string
+		wantErr string
+	}{
+		{"parse", `{{ .Project `, "failed to parse template"},
+		{"unknown function", `{{ shout .Project }}`, "failed to parse template"},
+		{"missing field", `{{ .NoSuchField }}`, "failed to execute template"},
+		{"wrong argument type", `{{ joinStrings ", " .Project }}`, "failed to execute template"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := cl.ApplyTemplate(tt.tmpl)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
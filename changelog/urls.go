@@ -0,0 +1,127 @@
+package changelog
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ghsaAdvisoryBaseURL is the prefix for GitHub Security Advisory pages.
+const ghsaAdvisoryBaseURL = "https://github.com/advisories/"
+
+// URLCheckResult reports whether a URL found in a changelog is reachable.
+type URLCheckResult struct {
+	URL        string `json:"url"`
+	Path       string `json:"path"` // JSON pointer to the field, e.g. "/releases/0/fixed/1/issue"
+	StatusCode int    `json:"statusCode,omitempty"`
+	Reachable  bool   `json:"reachable"`
+	Error      string `json:"error,omitempty"`
+}
+
+// ValidateURLs checks every URL in the changelog using http.DefaultClient.
+// See ValidateURLsWithClient.
+func (c *Changelog) ValidateURLs(ctx context.Context) ([]URLCheckResult, error) {
+	return c.ValidateURLsWithClient(ctx, http.DefaultClient)
+}
+
+// ValidateURLsWithClient makes a HEAD request to every URL in the changelog:
+// the repository, release compare URLs, entry issue, PR, and commit
+// references given as URLs, and GitHub advisory pages for GHSA IDs. A URL is
+// reachable if it responds with a status below 400; servers that do not
+// allow HEAD are retried with GET. Each distinct URL is requested once.
+// If ctx is done, the results so far are returned with ctx's error.
+func (c *Changelog) ValidateURLsWithClient(ctx context.Context, client *http.Client) ([]URLCheckResult, error) {
+	results := c.collectURLs()
+	checked := make(map[string]URLCheckResult)
+	for i := range results {
+		if err := ctx.Err(); err != nil {
+			return results[:i], err
+		}
+		r := &results[i]
+		prev, ok := checked[r.URL]
+		if !ok {
+			prev = checkURL(ctx, client, r.URL)
+			checked[r.URL] = prev
+		}
+		r.StatusCode, r.Reachable, r.Error = prev.StatusCode, prev.Reachable, prev.Error
+	}
+	return results, nil
+}
+
+// checkURL requests url with HEAD, falling back to GET if HEAD is not allowed.
+func checkURL(ctx context.Context, client *http.Client, url string) URLCheckResult {
+	result := URLCheckResult{URL: url}
+	for _, method := range []string{http.MethodHead, http.MethodGet} {
+		req, err := http.NewRequestWithContext(ctx, method, url, nil)
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			result.Error = err.Error()
+			return result
+		}
+		resp.Body.Close()
+		result.StatusCode = resp.StatusCode
+		if resp.StatusCode != http.StatusMethodNotAllowed && resp.StatusCode != http.StatusNotImplemented {
+			break
+		}
+	}
+	result.Reachable = result.StatusCode < 400
+	return result
+}
+
+// collectURLs returns the URLs in the changelog with their JSON pointers, in
+// document order.
+func (c *Changelog) collectURLs() []URLCheckResult {
+	var results []URLCheckResult
+	add := func(path, value string) {
+		if isHTTPURL(value) {
+			results = append(results, URLCheckResult{URL: value, Path: path})
+		}
+	}
+
+	add("/repository", c.Repository)
+	if c.Unreleased != nil {
+		collectReleaseURLs(c.Unreleased, "/unreleased", add)
+	}
+	for i := range c.Releases {
+		collectReleaseURLs(&c.Releases[i], fmt.Sprintf("/releases/%d", i), add)
+	}
+	return results
+}
+
+// collectReleaseURLs calls add for each field of a release that may hold a
+// URL, with JSON pointers under path.
+func collectReleaseURLs(r *Release, path string, add func(path, value string)) {
+	add(path+"/compareUrl", r.CompareURL)
+	for _, cat := range r.Categories() {
+		for i, e := range cat.Entries {
+			entryPath := fmt.Sprintf("%s/%s/%d", path, categoryJSONKey(cat.Name), i)
+			add(entryPath+"/issue", e.Issue)
+			add(entryPath+"/pr", e.PR)
+			add(entryPath+"/externalUrl", e.ExternalURL)
+			add(entryPath+"/commit", e.Commit)
+			if ghsaRegex.MatchString(e.GHSA) {
+				add(entryPath+"/ghsa", ghsaAdvisoryBaseURL+e.GHSA)
+			}
+		}
+	}
+}
+
+// isHTTPURL returns true if s is an http or https URL.
+func isHTTPURL(s string) bool {
+	return strings.HasPrefix(s, "https://") || strings.HasPrefix(s, "http://")
+}
+
+// categoryJSONKey returns the Release JSON field for a category name, e.g.
+// "upgradeGuide" for "Upgrade Guide".
+func categoryJSONKey(name string) string {
+	key := strings.ReplaceAll(name, " ", "")
+	if key == "" {
+		return key
+	}
+	return strings.ToLower(key[:1]) + key[1:]
+}
@@ -0,0 +1,97 @@
+package changelog
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestValidateURLs(t *testing.T) {
+	requests := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/get-only":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		}
+	}))
+	defer srv.Close()
+
+	cl := &Changelog{
+		IRVersion:  IRVersion,
+		Project:    "test",
+		Repository: srv.URL + "/repo",
+		Unreleased: &Release{Added: []Entry{{Description: "WIP", PR: srv.URL + "/get-only"}}},
+		Releases: []Release{
+			{
+				Version:      "1.0.0",
+				Date:         "2026-01-01",
+				CompareURL:   srv.URL + "/repo",
+				UpgradeGuide: []Entry{{Description: "Migrate", Issue: "42"}},
+				Fixed:        []Entry{{Description: "Fix", Issue: "7"}, {Description: "Fix 2", Issue: srv.URL + "/missing"}},
+			},
+		},
+	}
+
+	results, err := cl.ValidateURLs(context.Background())
+	if err != nil {
+		t.Fatalf("ValidateURLs failed: %v", err)
+	}
+
+	want := []URLCheckResult{
+		{URL: srv.URL + "/repo", Path: "/repository", StatusCode: http.StatusOK, Reachable: true},
+		{URL: srv.URL + "/get-only", Path: "/unreleased/added/0/pr", StatusCode: http.StatusOK, Reachable: true},
+		{URL: srv.URL + "/repo", Path: "/releases/0/compareUrl", StatusCode: http.StatusOK, Reachable: true},
+		{URL: srv.URL + "/missing", Path: "/releases/0/fixed/1/issue", StatusCode: http.StatusNotFound},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("expected %d results, got %d: %+v", len(want), len(results), results)
+	}
+	for i := range want {
+		if results[i] != want[i] {
+			t.Errorf("result %d: expected %+v, got %+v", i, want[i], results[i])
+		}
+	}
+	if requests["/repo"] != 1 {
+		t.Errorf("expected repeated URL to be requested once, got %d", requests["/repo"])
+	}
+}
+
+func TestValidateURLs_Unreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	cl := &Changelog{IRVersion: IRVersion, Project: "test", Repository: url}
+	results, err := cl.ValidateURLs(context.Background())
+	if err != nil {
+		t.Fatalf("ValidateURLs failed: %v", err)
+	}
+	if len(results) != 1 || results[0].Reachable || results[0].Error == "" {
+		t.Errorf("expected unreachable result with error, got %+v", results)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := cl.ValidateURLs(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestCategoryJSONKey(t *testing.T) {
+	for name, want := range map[string]string{
+		CategoryAdded:        "added",
+		CategoryUpgradeGuide: "upgradeGuide",
+		CategoryKnownIssues:  "knownIssues",
+	} {
+		if got := categoryJSONKey(name); got != want {
+			t.Errorf("categoryJSONKey(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package changelog
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -9,19 +10,26 @@ import (
 
 // Validation errors.
 var (
-	ErrEmptyProject      = errors.New("project name is required")
-	ErrInvalidIRVersion  = errors.New("invalid or unsupported IR version")
-	ErrInvalidVersion    = errors.New("invalid semantic version")
-	ErrInvalidDate       = errors.New("invalid date format (expected YYYY-MM-DD)")
-	ErrEmptyDescription  = errors.New("entry description is required")
-	ErrInvalidCVE        = errors.New("invalid CVE format")
-	ErrInvalidGHSA       = errors.New("invalid GHSA format")
-	ErrInvalidSeverity   = errors.New("invalid severity level")
-	ErrInvalidCVSSScore  = errors.New("CVSS score must be between 0 and 10")
-	ErrDuplicateVersion  = errors.New("duplicate version found")
-	ErrUnsortedReleases  = errors.New("releases are not in reverse chronological order")
-	ErrInvalidVersioning = errors.New("invalid versioning scheme")
-	ErrInvalidCommitConv = errors.New("invalid commit convention")
+	ErrEmptyProject        = errors.New("project name is required")
+	ErrInvalidIRVersion    = errors.New("invalid or unsupported IR version")
+	ErrInvalidVersion      = errors.New("invalid semantic version")
+	ErrInvalidDate         = errors.New("invalid date format (expected YYYY-MM-DD)")
+	ErrEmptyDescription    = errors.New("entry description is required")
+	ErrInvalidCVE          = errors.New("invalid CVE format")
+	ErrInvalidGHSA         = errors.New("invalid GHSA format")
+	ErrInvalidSeverity     = errors.New("invalid severity level")
+	ErrInvalidCVSSScore    = errors.New("CVSS score must be between 0 and 10")
+	ErrDuplicateVersion    = errors.New("duplicate version found")
+	ErrUnsortedReleases    = errors.New("releases are not in reverse chronological order")
+	ErrInvalidVersioning   = errors.New("invalid versioning scheme")
+	ErrInvalidCommitConv   = errors.New("invalid commit convention")
+	ErrEmptyAffects        = errors.New("affected component must not be empty")
+	ErrDateVersionMismatch = errors.New("release date order contradicts version order")
+	ErrNonMonotonicVersion = errors.New("release version is higher than the release listed before it")
+	ErrNonMonotonicDate    = errors.New("release is dated after the release listed before it")
+	ErrInvalidTagPath      = errors.New("invalid tag path")
+	ErrDuplicateMaintainer = errors.New("duplicate maintainer")
+	ErrDuplicateBot        = errors.New("duplicate bot")
 )
 
 var validVersioningSchemes = map[string]bool{
@@ -82,10 +90,39 @@ func (e *ValidationError) Unwrap() error {
 type ValidationResult struct {
 	Valid  bool
 	Errors []ValidationError
+
+	// ContextError is set when validation stopped early because the context
+	// was canceled or its deadline passed. It wraps context.Canceled or
+	// context.DeadlineExceeded. Errors then covers only the part validated.
+	ContextError error
+}
+
+// AsError returns nil if the result is valid, or otherwise an error joining
+// every ValidationError and the ContextError, if any. The returned error
+// implements Unwrap() []error, so errors.Is and errors.As see each of them.
+func (r ValidationResult) AsError() error {
+	if r.Valid {
+		return nil
+	}
+	errs := make([]error, 0, len(r.Errors)+1)
+	for i := range r.Errors {
+		errs = append(errs, &r.Errors[i])
+	}
+	if r.ContextError != nil {
+		errs = append(errs, r.ContextError)
+	}
+	return errors.Join(errs...)
 }
 
 // Validate validates the changelog structure and content.
 func (c *Changelog) Validate() ValidationResult {
+	return c.ValidateWithContext(context.Background())
+}
+
+// ValidateWithContext validates like Validate but checks ctx between releases
+// and entries, returning early with ContextError set if ctx is done. This
+// bounds validation time for editor plugins and services with timeouts.
+func (c *Changelog) ValidateWithContext(ctx context.Context) ValidationResult {
 	result := ValidationResult{Valid: true}
 
 	// Check required fields
@@ -93,8 +130,8 @@ func (c *Changelog) Validate() ValidationResult {
 		result.addError("project", "project name is required", ErrEmptyProject)
 	}
 
-	if c.IRVersion != IRVersion {
-		result.addError("ir_version", fmt.Sprintf("expected %s, got %s", IRVersion, c.IRVersion), ErrInvalidIRVersion)
+	if !IsSupportedIRVersion(c.IRVersion) {
+		result.addError("ir_version", fmt.Sprintf("expected one of %s, got %s", strings.Join(SupportedIRVersions, ", "), c.IRVersion), ErrInvalidIRVersion)
 	}
 
 	// Validate versioning scheme
@@ -109,14 +146,17 @@ func (c *Changelog) Validate() ValidationResult {
 
 	// Validate unreleased section
 	if c.Unreleased != nil {
-		c.validateRelease(c.Unreleased, "unreleased", &result, true)
+		c.validateRelease(ctx, c.Unreleased, "unreleased", &result, true)
 	}
 
 	// Validate releases
 	versions := make(map[string]bool)
 	for i, release := range c.Releases {
+		if result.checkContext(ctx) {
+			return result
+		}
 		field := fmt.Sprintf("releases[%d]", i)
-		c.validateRelease(&release, field, &result, false)
+		c.validateRelease(ctx, &release, field, &result, false)
 
 		// Check for duplicate versions
 		if release.Version != "" {
@@ -127,10 +167,11 @@ func (c *Changelog) Validate() ValidationResult {
 		}
 	}
 
+	result.checkContext(ctx)
 	return result
 }
 
-func (c *Changelog) validateRelease(r *Release, field string, result *ValidationResult, isUnreleased bool) {
+func (c *Changelog) validateRelease(ctx context.Context, r *Release, field string, result *ValidationResult, isUnreleased bool) {
 	// Version and date required for releases (not unreleased)
 	if !isUnreleased {
 		if r.Version == "" {
@@ -148,55 +189,63 @@ func (c *Changelog) validateRelease(r *Release, field string, result *Validation
 
 	// Validate all entries in canonical order
 	// Overview & Critical
-	c.validateEntries(r.Highlights, field+".highlights", result)
-	c.validateEntries(r.Breaking, field+".breaking", result)
-	c.validateEntries(r.UpgradeGuide, field+".upgrade_guide", result)
-	c.validateSecurityEntries(r.Security, field+".security", result)
+	c.validateEntries(ctx, r.Highlights, field+".highlights", result)
+	c.validateEntries(ctx, r.Breaking, field+".breaking", result)
+	c.validateEntries(ctx, r.UpgradeGuide, field+".upgrade_guide", result)
+	c.validateSecurityEntries(ctx, r.Security, field+".security", result)
 
 	// Core KACL
-	c.validateEntries(r.Added, field+".added", result)
-	c.validateEntries(r.Changed, field+".changed", result)
-	c.validateEntries(r.Deprecated, field+".deprecated", result)
-	c.validateEntries(r.Removed, field+".removed", result)
-	c.validateEntries(r.Fixed, field+".fixed", result)
+	c.validateEntries(ctx, r.Added, field+".added", result)
+	c.validateEntries(ctx, r.Changed, field+".changed", result)
+	c.validateEntries(ctx, r.Deprecated, field+".deprecated", result)
+	c.validateEntries(ctx, r.Removed, field+".removed", result)
+	c.validateEntries(ctx, r.Fixed, field+".fixed", result)
 
 	// Quality
-	c.validateEntries(r.Performance, field+".performance", result)
-	c.validateEntries(r.Dependencies, field+".dependencies", result)
+	c.validateEntries(ctx, r.Performance, field+".performance", result)
+	c.validateEntries(ctx, r.Dependencies, field+".dependencies", result)
 
 	// Development
-	c.validateEntries(r.Documentation, field+".documentation", result)
-	c.validateEntries(r.Build, field+".build", result)
+	c.validateEntries(ctx, r.Documentation, field+".documentation", result)
+	c.validateEntries(ctx, r.Build, field+".build", result)
 
 	// Operations
-	c.validateEntries(r.Infrastructure, field+".infrastructure", result)
-	c.validateEntries(r.Observability, field+".observability", result)
-	c.validateEntries(r.Compliance, field+".compliance", result)
+	c.validateEntries(ctx, r.Infrastructure, field+".infrastructure", result)
+	c.validateEntries(ctx, r.Observability, field+".observability", result)
+	c.validateEntries(ctx, r.Compliance, field+".compliance", result)
 
 	// Internal
-	c.validateEntries(r.Internal, field+".internal", result)
+	c.validateEntries(ctx, r.Internal, field+".internal", result)
 
 	// End Matter
-	c.validateEntries(r.KnownIssues, field+".known_issues", result)
-	c.validateEntries(r.Contributors, field+".contributors", result)
+	c.validateEntries(ctx, r.KnownIssues, field+".known_issues", result)
+	c.validateEntries(ctx, r.Contributors, field+".contributors", result)
 }
 
-func (c *Changelog) validateEntries(entries []Entry, field string, result *ValidationResult) {
+func (c *Changelog) validateEntries(ctx context.Context, entries []Entry, field string, result *ValidationResult) {
 	for i, entry := range entries {
+		if result.checkContext(ctx) {
+			return
+		}
 		entryField := fmt.Sprintf("%s[%d]", field, i)
 		if entry.Description == "" {
 			result.addError(entryField+".description", "description is required", ErrEmptyDescription)
 		}
+		validateAffects(entry, entryField, result)
 	}
 }
 
-func (c *Changelog) validateSecurityEntries(entries []Entry, field string, result *ValidationResult) {
+func (c *Changelog) validateSecurityEntries(ctx context.Context, entries []Entry, field string, result *ValidationResult) {
 	for i, entry := range entries {
+		if result.checkContext(ctx) {
+			return
+		}
 		entryField := fmt.Sprintf("%s[%d]", field, i)
 
 		if entry.Description == "" {
 			result.addError(entryField+".description", "description is required", ErrEmptyDescription)
 		}
+		validateAffects(entry, entryField, result)
 
 		if entry.CVE != "" && !cveRegex.MatchString(entry.CVE) {
 			result.addError(entryField+".cve", "invalid CVE format: "+entry.CVE, ErrInvalidCVE)
@@ -216,6 +265,28 @@ func (c *Changelog) validateSecurityEntries(entries []Entry, field string, resul
 	}
 }
 
+func validateAffects(entry Entry, field string, result *ValidationResult) {
+	for i, component := range entry.Affects {
+		if strings.TrimSpace(component) == "" {
+			result.addError(fmt.Sprintf("%s.affects[%d]", field, i), "affected component must not be empty", ErrEmptyAffects)
+		}
+	}
+}
+
+// checkContext records ctx's error in ContextError and reports whether
+// validation should stop. A validation cut short is never Valid.
+func (r *ValidationResult) checkContext(ctx context.Context) bool {
+	if r.ContextError != nil {
+		return true
+	}
+	if err := ctx.Err(); err != nil {
+		r.Valid = false
+		r.ContextError = fmt.Errorf("validation interrupted: %w", err)
+		return true
+	}
+	return false
+}
+
 func (r *ValidationResult) addError(field, message string, err error) {
 	r.Valid = false
 	r.Errors = append(r.Errors, ValidationError{
@@ -1,7 +1,9 @@
 package changelog
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -20,6 +22,7 @@ const (
 	ErrCodeInvalidIRVersion  ErrorCode = "E007"
 	ErrCodeInvalidVersioning ErrorCode = "E008"
 	ErrCodeInvalidCommitConv ErrorCode = "E009"
+	ErrCodeInvalidURL        ErrorCode = "E011"
 
 	// Structure errors (E1xx)
 	ErrCodeMissingField     ErrorCode = "E100"
@@ -34,6 +37,10 @@ const (
 	WarnCodeMissingSeverity  ErrorCode = "W004"
 	WarnCodeMissingCommit    ErrorCode = "W005"
 
+	WarnCodeDeprecatedSinceInWrongCategory ErrorCode = "W006"
+	WarnCodeMissingPlannedRemoval          ErrorCode = "W007"
+	WarnCodeNotesInsteadOfDescription      ErrorCode = "W008"
+
 	// Error codes for promoted warnings (E01x)
 	ErrCodeMissingCommit ErrorCode = "E010"
 )
@@ -89,6 +96,21 @@ type RichValidationResult struct {
 	Summary  RichValidationSummary `json:"summary"`
 }
 
+// AsError returns nil if the result is valid, or otherwise an error joining
+// every RichValidationError in Errors. Warnings are not included. The
+// returned error implements Unwrap() []error, so errors.Is and errors.As see
+// each of them.
+func (r RichValidationResult) AsError() error {
+	if r.Valid {
+		return nil
+	}
+	errs := make([]error, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		errs = append(errs, e)
+	}
+	return errors.Join(errs...)
+}
+
 // ValidateRich performs validation with rich, actionable error messages.
 func (c *Changelog) ValidateRich() RichValidationResult {
 	result := RichValidationResult{
@@ -109,7 +131,7 @@ func (c *Changelog) ValidateRich() RichValidationResult {
 		})
 	}
 
-	if c.IRVersion != IRVersion {
+	if !IsSupportedIRVersion(c.IRVersion) {
 		result.addError(RichValidationError{
 			Code:          ErrCodeInvalidIRVersion,
 			Severity:      SeverityError,
@@ -280,9 +302,54 @@ func (c *Changelog) validateReleaseRich(r *Release, field string, result *RichVa
 	entriesCount += c.validateEntriesRich(r.Contributors, field+".contributors", result)
 	c.validateCommitsRich(r.Contributors, field+".contributors", "contributors", result)
 
+	c.validateSinceRich(r, field, result)
+	c.validatePlannedRemovalRich(r.Deprecated, field+".deprecated", result)
+
 	return entriesCount
 }
 
+// validateSinceRich warns about entries outside the Deprecated category that
+// set Since, which only has meaning for deprecations.
+func (c *Changelog) validateSinceRich(r *Release, field string, result *RichValidationResult) {
+	for _, cat := range r.Categories() {
+		if cat.Name == CategoryDeprecated {
+			continue
+		}
+		catField := field + "." + categoryField(cat.Name)
+		for i, entry := range cat.Entries {
+			if entry.Since == "" {
+				continue
+			}
+			result.addWarning(RichValidationError{
+				Code:       WarnCodeDeprecatedSinceInWrongCategory,
+				Severity:   SeverityWarning,
+				Path:       fmt.Sprintf("%s[%d].since", catField, i),
+				Message:    "Since is only meaningful for Deprecated entries",
+				Actual:     cat.Name,
+				Expected:   CategoryDeprecated,
+				Suggestion: "Move the entry to Deprecated or remove the since field",
+			})
+		}
+	}
+}
+
+// validatePlannedRemovalRich warns about deprecated entries that do not say
+// when the deprecated feature will be removed.
+func (c *Changelog) validatePlannedRemovalRich(entries []Entry, field string, result *RichValidationResult) {
+	for i, entry := range entries {
+		if entry.PlannedRemoval != "" {
+			continue
+		}
+		result.addWarning(RichValidationError{
+			Code:       WarnCodeMissingPlannedRemoval,
+			Severity:   SeverityWarning,
+			Path:       fmt.Sprintf("%s[%d].plannedRemoval", field, i),
+			Message:    "Deprecated entry has no planned removal",
+			Suggestion: "Add plannedRemoval with the version or date the feature will be removed",
+		})
+	}
+}
+
 func (c *Changelog) validateEntriesRich(entries []Entry, field string, result *RichValidationResult) int {
 	for i, entry := range entries {
 		entryField := fmt.Sprintf("%s[%d]", field, i)
@@ -305,10 +372,48 @@ func (c *Changelog) validateEntriesRich(entries []Entry, field string, result *R
 				Suggestion: "Consider providing more detail about the change",
 			})
 		}
+		validateNotesRich(entry, entryField, result)
+		validateExternalURLRich(entry, entryField, result)
 	}
 	return len(entries)
 }
 
+// validateExternalURLRich checks that an entry's ExternalURL, if set, is an
+// absolute http or https URL.
+func validateExternalURLRich(entry Entry, entryField string, result *RichValidationResult) {
+	if entry.ExternalURL == "" {
+		return
+	}
+	if u, err := url.Parse(entry.ExternalURL); err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" {
+		return
+	}
+	result.addError(RichValidationError{
+		Code:       ErrCodeInvalidURL,
+		Severity:   SeverityError,
+		Path:       entryField + ".externalUrl",
+		Message:    "Invalid external URL",
+		Actual:     entry.ExternalURL,
+		Expected:   "Absolute http or https URL",
+		Suggestion: "Use the full tracker URL, e.g. \"https://example.atlassian.net/browse/PROJ-123\"",
+	})
+}
+
+// validateNotesRich warns when an entry has a very short description but
+// longer notes, which suggests the summary was written into Notes.
+func validateNotesRich(entry Entry, entryField string, result *RichValidationResult) {
+	if entry.Notes == "" || len(entry.Description) >= 20 || len(entry.Notes) <= len(entry.Description) {
+		return
+	}
+	result.addWarning(RichValidationError{
+		Code:       WarnCodeNotesInsteadOfDescription,
+		Severity:   SeverityWarning,
+		Path:       entryField + ".notes",
+		Message:    "Notes are longer than a very short description",
+		Actual:     entry.Description,
+		Suggestion: "Summarize the change in description and keep extra detail in notes",
+	})
+}
+
 func (c *Changelog) validateSecurityEntriesRich(entries []Entry, field string, result *RichValidationResult) int {
 	for i, entry := range entries {
 		entryField := fmt.Sprintf("%s[%d]", field, i)
@@ -393,6 +498,9 @@ func (c *Changelog) validateSecurityEntriesRich(entries []Entry, field string, r
 				Suggestion: "Add 'severity' field (critical, high, medium, low, or informational)",
 			})
 		}
+
+		validateNotesRich(entry, entryField, result)
+		validateExternalURLRich(entry, entryField, result)
 	}
 	return len(entries)
 }
@@ -1,6 +1,7 @@
 package changelog
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -416,6 +417,114 @@ func TestValidateRich_CommitPresent(t *testing.T) {
 	}
 }
 
+func TestValidateRich_SinceInWrongCategory(t *testing.T) {
+	cl := New("test-project")
+	cl.AddRelease(Release{
+		Version:    "1.0.0",
+		Date:       "2024-01-15",
+		Added:      []Entry{{Description: "New API", Commit: "abc1234", Since: "v0.9.0"}},
+		Deprecated: []Entry{{Description: "Old API", Commit: "def5678", Since: "v0.9.0"}},
+	})
+
+	result := cl.ValidateRich()
+
+	var found []RichValidationError
+	for _, warn := range result.Warnings {
+		if warn.Code == WarnCodeDeprecatedSinceInWrongCategory {
+			found = append(found, warn)
+		}
+	}
+	if len(found) != 1 {
+		t.Fatalf("expected 1 since warning, got %d: %v", len(found), found)
+	}
+	if found[0].Path != "releases[0].added[0].since" {
+		t.Errorf("unexpected path %q", found[0].Path)
+	}
+}
+
+func TestValidateRich_MissingPlannedRemoval(t *testing.T) {
+	cl := New("test-project")
+	cl.AddRelease(Release{
+		Version: "1.0.0",
+		Date:    "2024-01-15",
+		Deprecated: []Entry{
+			{Description: "Old API", Commit: "abc1234", PlannedRemoval: "v2.0.0"},
+			{Description: "Legacy flag", Commit: "def5678"},
+		},
+	})
+
+	result := cl.ValidateRich()
+
+	var found []RichValidationError
+	for _, warn := range result.Warnings {
+		if warn.Code == WarnCodeMissingPlannedRemoval {
+			found = append(found, warn)
+		}
+	}
+	if len(found) != 1 {
+		t.Fatalf("expected 1 planned removal warning, got %d: %v", len(found), found)
+	}
+	if found[0].Path != "releases[0].deprecated[1].plannedRemoval" {
+		t.Errorf("unexpected path %q", found[0].Path)
+	}
+}
+
+func TestValidateRich_NotesInsteadOfDescription(t *testing.T) {
+	cl := New("test-project")
+	cl.AddRelease(Release{
+		Version: "1.0.0",
+		Date:    "2024-01-15",
+		Added: []Entry{
+			{Description: "Auth", Commit: "abc1234", Notes: "Adds OAuth 2.0 login with refresh tokens"},
+			{Description: "Add OAuth 2.0 login support", Commit: "def5678", Notes: "Refresh tokens are rotated on every use for extra safety"},
+			{Description: "Fix typo in docs", Commit: "0123abc", Notes: "Typo"},
+		},
+	})
+
+	result := cl.ValidateRich()
+
+	var found []RichValidationError
+	for _, warn := range result.Warnings {
+		if warn.Code == WarnCodeNotesInsteadOfDescription {
+			found = append(found, warn)
+		}
+	}
+	if len(found) != 1 {
+		t.Fatalf("expected 1 notes warning, got %d: %v", len(found), found)
+	}
+	if found[0].Path != "releases[0].added[0].notes" {
+		t.Errorf("unexpected path %q", found[0].Path)
+	}
+}
+
+func TestValidateRich_InvalidExternalURL(t *testing.T) {
+	cl := New("test-project")
+	cl.AddRelease(Release{
+		Version: "1.0.0",
+		Date:    "2024-01-15",
+		Fixed: []Entry{
+			{Description: "Fix login redirect", Commit: "abc1234", ExternalURL: "https://linear.app/acme/issue/ENG-42"},
+			{Description: "Fix logout redirect", Commit: "def5678", ExternalURL: "linear.app/acme/issue/ENG-43"},
+		},
+		Security: []Entry{
+			{Description: "Fix XSS in search", CVE: "CVE-2024-1234", Severity: "high", Commit: "0123abc", ExternalURL: "ftp://example.com/SEC-1"},
+		},
+	})
+
+	result := cl.ValidateRich()
+
+	var paths []string
+	for _, err := range result.Errors {
+		if err.Code == ErrCodeInvalidURL {
+			paths = append(paths, err.Path)
+		}
+	}
+	want := []string{"releases[0].security[0].externalUrl", "releases[0].fixed[1].externalUrl"}
+	if len(paths) != len(want) || paths[0] != want[0] || paths[1] != want[1] {
+		t.Errorf("expected invalid URL errors at %v, got %v", want, paths)
+	}
+}
+
 func TestValidateRich_ExemptCategoriesNoCommitWarning(t *testing.T) {
 	cl := New("test-project")
 	cl.AddRelease(Release{
@@ -503,3 +612,27 @@ func TestValidateCommitsRich(t *testing.T) {
 		})
 	}
 }
+
+func TestRichValidationResult_AsError(t *testing.T) {
+	cl := New("test-project")
+	cl.AddRelease(Release{Version: "1.0.0", Date: "2024-01-15", Added: []Entry{{Description: "Added a new feature"}}})
+	if err := cl.ValidateRich().AsError(); err != nil {
+		t.Errorf("expected nil for valid result, got %v", err)
+	}
+
+	cl.AddRelease(Release{Version: "1.1.0", Date: "January 15, 2024"})
+	result := cl.ValidateRich()
+	err := result.AsError()
+	if err == nil {
+		t.Fatal("expected error for invalid result")
+	}
+	for _, e := range result.Errors {
+		if !errors.Is(err, e) {
+			t.Errorf("expected errors.Is to find %v", e)
+		}
+	}
+	var re RichValidationError
+	if !errors.As(err, &re) || re.Code != ErrCodeInvalidDate {
+		t.Errorf("expected errors.As to find the date error, got %+v", re)
+	}
+}
@@ -1,8 +1,10 @@
 package changelog
 
 import (
+	"context"
 	"errors"
 	"testing"
+	"time"
 )
 
 func TestValidate_Valid(t *testing.T) {
@@ -231,6 +233,38 @@ func TestValidationError_Unwrap(t *testing.T) {
 	}
 }
 
+func TestValidationResult_AsError(t *testing.T) {
+	if err := (ValidationResult{Valid: true}).AsError(); err != nil {
+		t.Errorf("expected nil for valid result, got %v", err)
+	}
+
+	cl := &Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []Release{
+			{Version: "invalid", Date: "01-03-2026"},
+		},
+	}
+
+	err := cl.Validate().AsError()
+	if err == nil {
+		t.Fatal("expected error for invalid changelog")
+	}
+	if !errors.Is(err, ErrInvalidVersion) || !errors.Is(err, ErrInvalidDate) {
+		t.Errorf("expected ErrInvalidVersion and ErrInvalidDate, got %v", err)
+	}
+	if errors.Is(err, ErrEmptyProject) {
+		t.Error("did not expect ErrEmptyProject")
+	}
+	var ve *ValidationError
+	if !errors.As(err, &ve) || ve.Field == "" {
+		t.Errorf("expected errors.As to find a ValidationError, got %v", ve)
+	}
+	if _, ok := err.(interface{ Unwrap() []error }); !ok {
+		t.Error("expected error to implement Unwrap() []error")
+	}
+}
+
 func TestValidate_InvalidIRVersion(t *testing.T) {
 	cl := &Changelog{
 		IRVersion: "2.0",
@@ -268,6 +302,49 @@ func TestValidate_EmptyDescription(t *testing.T) {
 	}
 }
 
+func TestValidate_EmptyAffects(t *testing.T) {
+	cl := &Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []Release{
+			{
+				Version: "1.0.0",
+				Date:    "2026-01-03",
+				Added:   []Entry{{Description: "New endpoint", Affects: []string{"api", ""}}},
+			},
+		},
+	}
+
+	result := cl.Validate()
+	if result.Valid {
+		t.Error("expected invalid changelog for empty affected component")
+	}
+	if !hasError(result.Errors, ErrEmptyAffects) {
+		t.Error("expected ErrEmptyAffects")
+	}
+	if result.Errors[0].Field != "releases[0].added[0].affects[1]" {
+		t.Errorf("unexpected field %q", result.Errors[0].Field)
+	}
+}
+
+func TestValidate_Component(t *testing.T) {
+	cl := &Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []Release{
+			{
+				Version: "1.0.0",
+				Date:    "2026-01-03",
+				Added:   []Entry{{Description: "Login command", Component: "cli"}},
+			},
+		},
+	}
+
+	if result := cl.Validate(); !result.Valid {
+		t.Errorf("expected valid changelog with component, got errors: %v", result.Errors)
+	}
+}
+
 func TestValidate_InvalidSeverity(t *testing.T) {
 	cl := &Changelog{
 		IRVersion: "1.0",
@@ -584,3 +661,69 @@ func TestParseTier_Invalid(t *testing.T) {
 		t.Errorf("expected ErrInvalidTier, got %v", err)
 	}
 }
+
+// cancelAfterContext reports context.Canceled after Err has been called n times.
+type cancelAfterContext struct {
+	context.Context
+	n int
+}
+
+func (c *cancelAfterContext) Err() error {
+	if c.n <= 0 {
+		return context.Canceled
+	}
+	c.n--
+	return nil
+}
+
+func TestValidateWithContext_Background(t *testing.T) {
+	bad := Release{Version: "1.0.0", Date: "bad-date", Added: []Entry{{Description: ""}, {Description: ""}}}
+	cl := &Changelog{IRVersion: IRVersion, Project: "test-project", Releases: []Release{bad, bad, bad}}
+
+	result := cl.ValidateWithContext(context.Background())
+	if result.ContextError != nil {
+		t.Errorf("unexpected ContextError: %v", result.ContextError)
+	}
+	if len(result.Errors) != len(cl.Validate().Errors) {
+		t.Errorf("expected same errors as Validate, got %d vs %d", len(result.Errors), len(cl.Validate().Errors))
+	}
+}
+
+func TestValidateWithContext_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := New("test-project").ValidateWithContext(ctx)
+	if !errors.Is(result.ContextError, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", result.ContextError)
+	}
+	if result.Valid {
+		t.Error("expected interrupted validation to be invalid")
+	}
+}
+
+func TestValidateWithContext_DeadlineExceeded(t *testing.T) {
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	result := New("test-project").ValidateWithContext(ctx)
+	if !errors.Is(result.ContextError, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", result.ContextError)
+	}
+}
+
+func TestValidateWithContext_StopsBetweenIterations(t *testing.T) {
+	bad := Release{Version: "1.0.0", Date: "bad-date", Added: []Entry{{Description: ""}, {Description: ""}}}
+	cl := &Changelog{IRVersion: IRVersion, Project: "test-project", Releases: []Release{bad, bad, bad}}
+	full := cl.Validate()
+
+	// Allow the first release and one entry to be checked, then cancel
+	result := cl.ValidateWithContext(&cancelAfterContext{Context: context.Background(), n: 2})
+
+	if !errors.Is(result.ContextError, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", result.ContextError)
+	}
+	if len(result.Errors) == 0 || len(result.Errors) >= len(full.Errors) {
+		t.Errorf("expected partial errors, got %d of %d", len(result.Errors), len(full.Errors))
+	}
+}
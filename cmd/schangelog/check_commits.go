@@ -0,0 +1,93 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/gitlog"
+)
+
+var (
+	checkCommitsSince  string
+	checkCommitsUntil  string
+	checkCommitsStrict bool
+)
+
+var checkCommitsCmd = &cobra.Command{
+	Use:   "check-commits",
+	Short: "Check that commit messages follow Conventional Commits",
+	Long: `Check that commit subjects follow the Conventional Commits format
+(type(scope)!: subject). Merge commits are ignored.
+
+With --strict, conforming commits are also checked against the spec
+strictly: the type must be lowercase, a scope if present must not be
+empty, the subject must start with a lowercase letter (unless it begins
+with a proper noun such as "GitHub" or "API"), and the subject must not
+end with a period.
+
+Exits with status 1 if any commit does not conform.
+
+Examples:
+  # Check commits since a tag
+  schangelog check-commits --since=v1.0.0
+
+  # Strictly check commits between two refs
+  schangelog check-commits --since=v1.0.0 --until=v1.1.0 --strict`,
+	RunE: runCheckCommits,
+}
+
+func init() {
+	checkCommitsCmd.Flags().StringVar(&checkCommitsSince, "since", "", "Check commits after this ref (tag, branch, or commit)")
+	checkCommitsCmd.Flags().StringVar(&checkCommitsUntil, "until", "HEAD", "Check commits up to this ref (default: HEAD)")
+	checkCommitsCmd.Flags().BoolVar(&checkCommitsStrict, "strict", false, "Also enforce lowercase type and subject, non-empty scope, and no trailing period")
+	rootCmd.AddCommand(checkCommitsCmd)
+}
+
+func runCheckCommits(cmd *cobra.Command, args []string) error {
+	gitArgs := []string{"log", "--format=" + gitlog.GitLogFormat, "--no-merges"}
+	if checkCommitsSince != "" {
+		gitArgs = append(gitArgs, fmt.Sprintf("%s..%s", checkCommitsSince, checkCommitsUntil))
+	} else {
+		gitArgs = append(gitArgs, checkCommitsUntil)
+	}
+
+	output, err := runGitLog(gitArgs)
+	if err != nil {
+		return err
+	}
+
+	result, err := gitlog.NewParser().Parse(output)
+	if err != nil {
+		return fmt.Errorf("failed to parse git log: %w", err)
+	}
+
+	var failures int
+	for _, c := range result.Commits {
+		var problem string
+		if cc := gitlog.ParseConventionalCommit(c.Message); cc == nil {
+			problem = "not a conventional commit"
+		} else if checkCommitsStrict {
+			if err := cc.Validate(); err != nil {
+				problem = err.Error()
+			}
+		}
+		if problem == "" {
+			continue
+		}
+		if failures == 0 {
+			fmt.Fprintln(os.Stderr, "Non-conforming commits:")
+		}
+		failures++
+		fmt.Fprintf(os.Stderr, "  ✗ %s %s\n", c.ShortHash, c.Message)
+		fmt.Fprintf(os.Stderr, "      %s\n", strings.ReplaceAll(problem, "\n", "\n      "))
+	}
+	if failures > 0 {
+		return fmt.Errorf("%d of %d commit(s) do not conform", failures, len(result.Commits))
+	}
+
+	fmt.Printf("✓ All %d commit(s) conform\n", len(result.Commits))
+	return nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+var checkFormatCmd = &cobra.Command{
+	Use:   "check-format <file>",
+	Short: "Check that a CHANGELOG.json file is well-formed JSON",
+	Long: `Check that a Structured Changelog JSON file is well-formed JSON with
+only known field names, without running full validation.
+
+Reports the line and column of:
+  - JSON syntax errors (missing commas, unclosed brackets, ...)
+  - Values of the wrong type (e.g., a number where a string is expected)
+  - Unknown field names, such as typos like "relases"
+
+Exits with status 0 if the file is well-formed and 1 otherwise. This is
+faster than validate and suited to git pre-commit hooks.
+
+Examples:
+  schangelog check-format CHANGELOG.json
+  schangelog check-format CHANGELOG.json && schangelog validate CHANGELOG.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCheckFormat,
+}
+
+func init() {
+	rootCmd.AddCommand(checkFormatCmd)
+}
+
+func runCheckFormat(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+
+	data, err := os.ReadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", inputFile, err)
+	}
+
+	if err := changelog.CheckFormat(data); err != nil {
+		var fe *changelog.FormatError
+		if errors.As(err, &fe) {
+			fmt.Fprintf(os.Stderr, "  ✗ %s:%d:%d: %v\n", inputFile, fe.Line, fe.Column, fe.Err)
+		} else {
+			fmt.Fprintf(os.Stderr, "  ✗ %s: %v\n", inputFile, err)
+		}
+		return fmt.Errorf("format check failed")
+	}
+
+	fmt.Printf("✓ %s is well-formed\n", inputFile)
+	return nil
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+	"github.com/grokify/structured-changelog/gitlog"
+)
+
+var (
+	checkSignoffsSince     string
+	checkSignoffsUntil     string
+	checkSignoffsChangelog string
+)
+
+var checkSignoffsCmd = &cobra.Command{
+	Use:   "check-signoffs",
+	Short: "Check that external commits have a Signed-off-by trailer",
+	Long: `Check that commits from external contributors carry a Signed-off-by
+trailer (Developer Certificate of Origin sign-off).
+
+Maintainers and bots listed in the changelog are treated as team members;
+all other authors are external. Merge commits are ignored.
+
+Exits with status 1 if any external commit is not signed off.
+
+Examples:
+  # Check commits since a tag
+  schangelog check-signoffs --since=v1.0.0
+
+  # Check commits between two refs using a specific changelog
+  schangelog check-signoffs --since=v1.0.0 --until=v1.1.0 --changelog=docs/CHANGELOG.json`,
+	RunE: runCheckSignoffs,
+}
+
+func init() {
+	checkSignoffsCmd.Flags().StringVar(&checkSignoffsSince, "since", "", "Check commits after this ref (tag, branch, or commit)")
+	checkSignoffsCmd.Flags().StringVar(&checkSignoffsUntil, "until", "HEAD", "Check commits up to this ref (default: HEAD)")
+	checkSignoffsCmd.Flags().StringVar(&checkSignoffsChangelog, "changelog", "CHANGELOG.json", "CHANGELOG.json to read maintainers/bots for external contributor detection")
+	rootCmd.AddCommand(checkSignoffsCmd)
+}
+
+func runCheckSignoffs(cmd *cobra.Command, args []string) error {
+	cl, err := changelog.LoadFile(checkSignoffsChangelog)
+	if err != nil {
+		return fmt.Errorf("failed to load changelog %s: %w", checkSignoffsChangelog, err)
+	}
+
+	gitArgs := []string{"log", "--format=" + gitlog.GitLogFormat, "--no-merges"}
+	if checkSignoffsSince != "" {
+		gitArgs = append(gitArgs, fmt.Sprintf("%s..%s", checkSignoffsSince, checkSignoffsUntil))
+	} else {
+		gitArgs = append(gitArgs, checkSignoffsUntil)
+	}
+
+	output, err := runGitLog(gitArgs)
+	if err != nil {
+		return err
+	}
+
+	result, err := gitlog.NewParser().Parse(output)
+	if err != nil {
+		return fmt.Errorf("failed to parse git log: %w", err)
+	}
+
+	for i := range result.Commits {
+		c := &result.Commits[i]
+		c.IsExternal = !cl.IsTeamMemberByNameAndEmail(c.Author, c.AuthorEmail)
+	}
+
+	unsigned := result.UnsignedOffCommits()
+	if len(unsigned) > 0 {
+		fmt.Fprintln(os.Stderr, "Commits missing Signed-off-by:")
+		for _, c := range unsigned {
+			fmt.Fprintf(os.Stderr, "  ✗ %s %s (%s <%s>)\n", c.ShortHash, c.Message, c.Author, c.AuthorEmail)
+		}
+		return fmt.Errorf("%d external commit(s) not signed off", len(unsigned))
+	}
+
+	fmt.Printf("✓ All external commits in %d commit(s) are signed off\n", len(result.Commits))
+	return nil
+}
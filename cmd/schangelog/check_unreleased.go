@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+var (
+	checkUnreleasedMinEntries      int
+	checkUnreleasedRequireCategory []string
+)
+
+var checkUnreleasedCmd = &cobra.Command{
+	Use:   "check-unreleased <file>",
+	Short: "Verify the Unreleased section has entries",
+	Long: `Verify that a CHANGELOG.json file has at least one entry in its
+Unreleased section. Intended for CI pre-release checks before tagging.
+
+Exits with status 0 if the checks pass and 1 otherwise.
+
+Checks:
+  --min-entries        Require at least N total entries across all unreleased categories
+  --require-category   Require a category to be non-empty (repeatable)
+
+Examples:
+  schangelog check-unreleased CHANGELOG.json
+  schangelog check-unreleased CHANGELOG.json --min-entries=3
+  schangelog check-unreleased CHANGELOG.json --require-category=Added
+  schangelog check-unreleased CHANGELOG.json --require-category=Added --require-category=Fixed`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCheckUnreleased,
+}
+
+func init() {
+	checkUnreleasedCmd.Flags().IntVar(&checkUnreleasedMinEntries, "min-entries", 1, "Minimum number of unreleased entries required")
+	checkUnreleasedCmd.Flags().StringArrayVar(&checkUnreleasedRequireCategory, "require-category", nil, "Category that must have unreleased entries (e.g., Added)")
+	rootCmd.AddCommand(checkUnreleasedCmd)
+}
+
+func runCheckUnreleased(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+
+	cl, err := changelog.LoadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", inputFile, err)
+	}
+
+	for _, cat := range checkUnreleasedRequireCategory {
+		if !changelog.DefaultRegistry.IsValidName(cat) {
+			return fmt.Errorf("unknown category %q", cat)
+		}
+	}
+
+	failures := checkUnreleased(cl.Unreleased, checkUnreleasedMinEntries, checkUnreleasedRequireCategory)
+	if len(failures) > 0 {
+		fmt.Fprintf(os.Stderr, "Unreleased check failed for %s:\n", inputFile)
+		for _, f := range failures {
+			fmt.Fprintf(os.Stderr, "  ✗ %s\n", f)
+		}
+		return fmt.Errorf("unreleased check failed with %d error(s)", len(failures))
+	}
+
+	fmt.Printf("✓ %s has unreleased changes\n", inputFile)
+	return nil
+}
+
+// checkUnreleased returns a description of each failed check for the
+// unreleased section. An empty result means all checks passed.
+func checkUnreleased(r *changelog.Release, minEntries int, requireCategories []string) []string {
+	if r == nil || r.IsEmpty() {
+		return []string{"unreleased section is empty"}
+	}
+
+	var failures []string
+
+	total := 0
+	for _, cat := range r.Categories() {
+		total += len(cat.Entries)
+	}
+	if total < minEntries {
+		failures = append(failures, fmt.Sprintf("unreleased section has %d entries, at least %d required", total, minEntries))
+	}
+
+	for _, cat := range requireCategories {
+		if !r.HasCategory(cat) {
+			failures = append(failures, fmt.Sprintf("unreleased section has no %s entries", cat))
+		}
+	}
+
+	return failures
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+var checkURLsTimeout time.Duration
+
+var checkURLsCmd = &cobra.Command{
+	Use:   "check-urls <file>",
+	Short: "Check that URLs in a changelog are reachable",
+	Long: `Check that the URLs in a Structured Changelog JSON file are reachable.
+
+A HEAD request is made to the repository URL, release compare URLs,
+issue, PR, and commit references given as URLs, and the GitHub advisory
+page of each GHSA ID. Responses with status 400 or above, and requests
+that fail, are reported with the JSON pointer of the field.
+
+Exits with status 1 if any URL is unreachable.
+
+Examples:
+  schangelog check-urls CHANGELOG.json
+  schangelog check-urls CHANGELOG.json --timeout=30s`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCheckURLs,
+}
+
+func init() {
+	checkURLsCmd.Flags().DurationVar(&checkURLsTimeout, "timeout", 10*time.Second, "Timeout for each request")
+	rootCmd.AddCommand(checkURLsCmd)
+}
+
+func runCheckURLs(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+
+	cl, err := changelog.LoadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", inputFile, err)
+	}
+
+	client := &http.Client{Timeout: checkURLsTimeout}
+	results, err := cl.ValidateURLsWithClient(context.Background(), client)
+	if err != nil {
+		return fmt.Errorf("failed to check URLs: %w", err)
+	}
+
+	var failures int
+	for _, r := range results {
+		if r.Reachable {
+			continue
+		}
+		if failures == 0 {
+			fmt.Fprintf(os.Stderr, "Unreachable URLs in %s:\n", inputFile)
+		}
+		failures++
+		if r.Error != "" {
+			fmt.Fprintf(os.Stderr, "  ✗ %s: %s (%s)\n", r.Path, r.URL, r.Error)
+		} else {
+			fmt.Fprintf(os.Stderr, "  ✗ %s: %s (%d)\n", r.Path, r.URL, r.StatusCode)
+		}
+	}
+	if failures > 0 {
+		return fmt.Errorf("%d of %d URL(s) unreachable", failures, len(results))
+	}
+
+	fmt.Printf("✓ All %d URL(s) in %s are reachable\n", len(results), inputFile)
+	return nil
+}
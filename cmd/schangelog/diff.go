@@ -0,0 +1,129 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+	"github.com/grokify/structured-changelog/format"
+)
+
+var diffFormat string
+
+// errChangelogsDiffer signals that the compared changelogs have differences.
+var errChangelogsDiffer = errors.New("changelogs differ")
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <old.json> <new.json>",
+	Short: "Show changes between two CHANGELOG.json files",
+	Long: `Compare two Structured Changelog JSON files and show which releases
+were added or removed, and which entries were added or removed per
+category in releases present in both.
+
+Like diff, exits with status 0 when the files have no differences,
+1 when differences are found, and 2 when a file cannot be loaded or
+the output cannot be produced.
+
+Output formats (with --format flag):
+  unified       Unified diff style output (default)
+  json          Structured JSON
+  json-compact  Minified JSON
+  toon          Token-Oriented Object Notation
+
+Examples:
+  schangelog diff CHANGELOG.old.json CHANGELOG.json
+  schangelog diff CHANGELOG.old.json CHANGELOG.json --format=json`,
+	Args: cobra.ExactArgs(2),
+	RunE: runDiff,
+}
+
+func init() {
+	diffCmd.Flags().StringVar(&diffFormat, "format", "unified", "Output format: unified, json, json-compact, toon")
+	rootCmd.AddCommand(diffCmd)
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	oldFile, newFile := args[0], args[1]
+	cmd.SilenceUsage = true
+
+	oldCL, err := changelog.LoadFile(oldFile)
+	if err != nil {
+		return &exitError{code: 2, err: fmt.Errorf("failed to load %s: %w", oldFile, err)}
+	}
+	newCL, err := changelog.LoadFile(newFile)
+	if err != nil {
+		return &exitError{code: 2, err: fmt.Errorf("failed to load %s: %w", newFile, err)}
+	}
+
+	d := oldCL.Diff(newCL)
+
+	if diffFormat == "unified" {
+		fmt.Print(formatUnifiedDiff(d, oldFile, newFile))
+	} else {
+		f, err := format.Parse(diffFormat)
+		if err != nil {
+			return &exitError{code: 2, err: err}
+		}
+		output, err := format.Marshal(d, f)
+		if err != nil {
+			return &exitError{code: 2, err: fmt.Errorf("failed to marshal output: %w", err)}
+		}
+		fmt.Println(string(output))
+	}
+
+	if !d.IsEmpty() {
+		// The differences are the output; exit 1 without an error message
+		cmd.SilenceErrors = true
+		return &exitError{code: 1, err: errChangelogsDiffer}
+	}
+	return nil
+}
+
+// formatUnifiedDiff renders a changelog diff in unified diff style, with
+// "+" for added lines, "-" for removed lines, and "@@" hunks for releases
+// whose entries changed. Returns an empty string when there are no differences.
+func formatUnifiedDiff(d changelog.ChangelogDiff, oldName, newName string) string {
+	if d.IsEmpty() {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldName, newName)
+
+	for i := range d.AddedReleases {
+		writeReleaseLines(&sb, "+", &d.AddedReleases[i])
+	}
+
+	for _, rd := range d.ChangedReleases {
+		fmt.Fprintf(&sb, "@@ [%s] @@\n", rd.Version)
+		for _, cat := range rd.Categories {
+			fmt.Fprintf(&sb, " ### %s\n", cat.Name)
+			for _, e := range cat.Removed {
+				fmt.Fprintf(&sb, "-- %s\n", e.Description)
+			}
+			for _, e := range cat.Added {
+				fmt.Fprintf(&sb, "+- %s\n", e.Description)
+			}
+		}
+	}
+
+	for i := range d.RemovedReleases {
+		writeReleaseLines(&sb, "-", &d.RemovedReleases[i])
+	}
+
+	return sb.String()
+}
+
+// writeReleaseLines writes a whole release with every line prefixed by marker.
+func writeReleaseLines(sb *strings.Builder, marker string, r *changelog.Release) {
+	fmt.Fprintf(sb, "%s## [%s] - %s\n", marker, r.Version, r.Date)
+	for _, cat := range r.Categories() {
+		fmt.Fprintf(sb, "%s### %s\n", marker, cat.Name)
+		for _, e := range cat.Entries {
+			fmt.Fprintf(sb, "%s- %s\n", marker, e.Description)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+var exportNVDOutput string
+
+var exportNVDCmd = &cobra.Command{
+	Use:   "export-nvd <file>",
+	Short: "Export security entries as an NVD CVE JSON feed",
+	Long: `Export the Security entries with a CVE from all releases of a
+Structured Changelog JSON file as a JSON array of NVD CVE JSON 1.1 items.
+
+Each item includes the CVE ID, description, CWE, GitHub advisory
+reference, and CVSS v3 score and severity when present, with the
+release date as the published date. Configurations are left empty.
+
+Examples:
+  schangelog export-nvd CHANGELOG.json
+  schangelog export-nvd CHANGELOG.json -o nvd.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runExportNVD,
+}
+
+func init() {
+	exportNVDCmd.Flags().StringVarP(&exportNVDOutput, "output", "o", "", "Output file (default: stdout)")
+	rootCmd.AddCommand(exportNVDCmd)
+}
+
+func runExportNVD(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+
+	cl, err := changelog.LoadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", inputFile, err)
+	}
+
+	items := cl.NVDItems()
+	data, err := json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal NVD items: %w", err)
+	}
+
+	if exportNVDOutput == "" {
+		fmt.Println(string(data))
+		return nil
+	}
+	if err := os.WriteFile(exportNVDOutput, append(data, '\n'), 0644); err != nil { //nolint:gosec // 0644 intentional for readable output
+		return fmt.Errorf("failed to write %s: %w", exportNVDOutput, err)
+	}
+	fmt.Fprintf(os.Stderr, "Exported %d CVE(s) to %s\n", len(items), exportNVDOutput)
+	return nil
+}
@@ -3,7 +3,9 @@ package main
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -20,6 +22,13 @@ var (
 	generateLocaleFile        string
 	generateAllReleases       bool
 	generateNotableCategories string
+	generateFormat            string
+	generateVersion           string
+	generateSplit             bool
+	generateOutputDir         string
+	generateIndex             string
+	generateWatch             bool
+	generateWatchTimeout      time.Duration
 )
 
 var generateCmd = &cobra.Command{
@@ -41,6 +50,14 @@ Output options:
   --locale-file         Path to JSON file with locale message overrides
   --all-releases        Include all releases (overrides default notable-only behavior)
   --notable-categories  Custom notable categories (comma-separated)
+  --format              Output format: markdown (default) or github-release
+  --version             Render only this release ("unreleased" for the Unreleased section);
+                        with --format=github-release, defaults to the latest release
+  --split               Write one Markdown file per release (e.g., v1.0.0.md) plus an index
+  --output-dir          Directory for --split output (default: current directory)
+  --index               Index file name for --split, containing a release table (default: index.md)
+  --watch               Regenerate whenever the input file changes, until Ctrl-C
+  --watch-timeout       Stop watching after this duration (e.g., 30s; default: no timeout)
 
 Tiers:
   core       KACL standard types (Security, Added, Changed, Deprecated, Removed, Fixed)
@@ -64,7 +81,11 @@ Examples:
   schangelog generate CHANGELOG.json --full -o docs/CHANGELOG.md
   schangelog generate CHANGELOG.json --locale=fr
   schangelog generate CHANGELOG.json --all-releases
-  schangelog generate CHANGELOG.json --notable-categories "Security,Added,Fixed"`,
+  schangelog generate CHANGELOG.json --notable-categories "Security,Added,Fixed"
+  schangelog generate CHANGELOG.json --version=v1.2.0
+  schangelog generate CHANGELOG.json --format=github-release --version=v1.2.0
+  schangelog generate CHANGELOG.json --split --output-dir docs/changelog
+  schangelog generate CHANGELOG.json -o CHANGELOG.md --watch`,
 	Args: cobra.ExactArgs(1),
 	RunE: runGenerate,
 }
@@ -78,12 +99,31 @@ func init() {
 	generateCmd.Flags().StringVar(&generateLocaleFile, "locale-file", "", "Path to locale override JSON file")
 	generateCmd.Flags().BoolVar(&generateAllReleases, "all-releases", false, "Include all releases (overrides default notable-only)")
 	generateCmd.Flags().StringVar(&generateNotableCategories, "notable-categories", "", "Custom notable categories (comma-separated)")
+	generateCmd.Flags().StringVar(&generateFormat, "format", "markdown", "Output format: markdown, github-release")
+	generateCmd.Flags().StringVar(&generateVersion, "version", "", "Render only this release version, or \"unreleased\"")
+	generateCmd.Flags().BoolVar(&generateSplit, "split", false, "Write one Markdown file per release plus an index file")
+	generateCmd.Flags().StringVar(&generateOutputDir, "output-dir", ".", "Output directory for --split")
+	generateCmd.Flags().StringVar(&generateIndex, "index", "index.md", "Index file name for --split")
+	generateCmd.Flags().BoolVar(&generateWatch, "watch", false, "Regenerate whenever the input file changes")
+	generateCmd.Flags().DurationVar(&generateWatchTimeout, "watch-timeout", 0, "Stop watching after this duration (0: until Ctrl-C)")
 	rootCmd.AddCommand(generateCmd)
 }
 
 func runGenerate(cmd *cobra.Command, args []string) error {
 	inputFile := args[0]
 
+	if generateWatch {
+		return runGenerateWatch(inputFile)
+	}
+	if generateWatchTimeout != 0 {
+		return fmt.Errorf("--watch-timeout requires --watch")
+	}
+	return generateFromFile(inputFile)
+}
+
+// generateFromFile loads, validates, and renders inputFile according to the
+// generate flags.
+func generateFromFile(inputFile string) error {
 	// Load changelog
 	cl, err := changelog.LoadFile(inputFile)
 	if err != nil {
@@ -100,6 +140,12 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("validation failed with %d error(s)", len(result.Errors))
 	}
 
+	if generateFormat == "github-release" {
+		return runGenerateGitHubRelease(cl, inputFile)
+	} else if generateFormat != "markdown" {
+		return fmt.Errorf("unsupported format %q (must be markdown or github-release)", generateFormat)
+	}
+
 	// Select options using library function
 	preset := "default"
 	if generateMinimal {
@@ -131,19 +177,111 @@ func runGenerate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid options: %w", err)
 	}
 
+	if generateSplit {
+		if generateVersion != "" {
+			return fmt.Errorf("--version cannot be used with --split")
+		}
+		return runGenerateSplit(cl, opts, inputFile)
+	}
+
+	if generateVersion != "" {
+		md, err := renderer.RenderMarkdownSection(cl, generateVersion, opts)
+		if err != nil {
+			// Exit status 2 distinguishes a missing version from other failures
+			return &exitError{code: 2, err: err}
+		}
+		return writeGenerateOutput(md, inputFile)
+	}
+
 	// Render
 	md := renderer.RenderMarkdownWithOptions(cl, opts)
 
-	// Write output
-	if generateOutput == "" {
-		// Write to stdout
-		fmt.Print(md)
-	} else {
-		if err := os.WriteFile(generateOutput, []byte(md), 0644); err != nil { //nolint:gosec // 0644 intentional for readable output
-			return fmt.Errorf("failed to write %s: %w", generateOutput, err)
+	return writeGenerateOutput(md, inputFile)
+}
+
+// runGenerateGitHubRelease renders a single release in GitHub Releases body format.
+func runGenerateGitHubRelease(cl *changelog.Changelog, inputFile string) error {
+	version := generateVersion
+	if version == "" {
+		if len(cl.Releases) == 0 {
+			return fmt.Errorf("no releases found in %s", inputFile)
 		}
-		fmt.Fprintf(os.Stderr, "Generated %s from %s\n", generateOutput, inputFile)
+		version = cl.Releases[0].Version
+	}
+
+	notes, err := renderer.RenderGitHubReleaseNotes(cl, version)
+	if err != nil {
+		return fmt.Errorf("failed to render release notes: %w", err)
+	}
+
+	return writeGenerateOutput(notes, inputFile)
+}
+
+// runGenerateSplit writes one Markdown file per release into --output-dir,
+// plus an index file containing the release summary table.
+func runGenerateSplit(cl *changelog.Changelog, opts renderer.Options, inputFile string) error {
+	if generateOutput != "" {
+		return fmt.Errorf("--output cannot be used with --split (use --output-dir)")
+	}
+	if err := os.MkdirAll(generateOutputDir, 0755); err != nil { //nolint:gosec // 0755 intentional for readable output
+		return fmt.Errorf("failed to create %s: %w", generateOutputDir, err)
 	}
 
+	policy := opts.NotabilityPolicy
+	if policy == nil {
+		policy = changelog.DefaultNotabilityPolicy()
+	}
+
+	var versions []string
+	if cl.Unreleased != nil && !cl.Unreleased.IsEmpty() {
+		versions = append(versions, "unreleased")
+	}
+	for i := range cl.Releases {
+		if opts.NotableOnly && !cl.Releases[i].IsNotable(policy) {
+			continue
+		}
+		versions = append(versions, cl.Releases[i].Version)
+	}
+
+	for _, version := range versions {
+		md, err := renderer.RenderMarkdownSection(cl, version, opts)
+		if err != nil {
+			return fmt.Errorf("failed to render %s: %w", version, err)
+		}
+		name := strings.ReplaceAll(version, "/", "-") + ".md"
+		if err := writeGenerateFile(filepath.Join(generateOutputDir, name), md); err != nil {
+			return err
+		}
+	}
+
+	index := renderer.RenderMarkdownTable(cl, opts)
+	if err := writeGenerateFile(filepath.Join(generateOutputDir, generateIndex), index); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Generated %d release files and %s in %s from %s\n",
+		len(versions), generateIndex, generateOutputDir, inputFile)
+	return nil
+}
+
+// writeGenerateFile writes rendered output to path.
+func writeGenerateFile(path, content string) error {
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil { //nolint:gosec // 0644 intentional for readable output
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// writeGenerateOutput writes rendered output to --output or stdout.
+func writeGenerateOutput(content, inputFile string) error {
+	if generateOutput == "" {
+		// Write to stdout
+		fmt.Print(content)
+		return nil
+	}
+	if err := os.WriteFile(generateOutput, []byte(content), 0644); err != nil { //nolint:gosec // 0644 intentional for readable output
+		return fmt.Errorf("failed to write %s: %w", generateOutput, err)
+	}
+	fmt.Fprintf(os.Stderr, "Generated %s from %s\n", generateOutput, inputFile)
 	return nil
 }
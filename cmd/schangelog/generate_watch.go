@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+)
+
+const (
+	// watchPollInterval is how often the input file is checked for changes.
+	watchPollInterval = 100 * time.Millisecond
+	// watchDebounce is how long the input file must stay unchanged before
+	// regenerating, so that a burst of writes triggers a single regeneration.
+	watchDebounce = 200 * time.Millisecond
+)
+
+// fileStamp identifies a version of a file by its modification time and size.
+type fileStamp struct {
+	modTime int64
+	size    int64
+}
+
+// statFileStamp returns the current fileStamp of path.
+func statFileStamp(path string) (fileStamp, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}, err
+	}
+	return fileStamp{modTime: info.ModTime().UnixNano(), size: info.Size()}, nil
+}
+
+// runGenerateWatch generates once, then regenerates each time inputFile
+// changes until interrupted or --watch-timeout elapses. Generation errors are
+// reported and watching continues.
+func runGenerateWatch(inputFile string) error {
+	if generateOutput == "" && !generateSplit {
+		return fmt.Errorf("--watch requires --output or --split")
+	}
+	if generateWatchTimeout < 0 {
+		return fmt.Errorf("--watch-timeout must not be negative")
+	}
+	if _, err := os.Stat(inputFile); err != nil {
+		return fmt.Errorf("failed to stat %s: %w", inputFile, err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if generateWatchTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, generateWatchTimeout)
+		defer cancel()
+	}
+
+	regenerate := func() {
+		err := generateFromFile(inputFile)
+		now := time.Now().Format(time.TimeOnly)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "[%s] ✗ %v\n", now, err)
+			return
+		}
+		fmt.Fprintf(os.Stderr, "[%s] ✓ Regenerated from %s\n", now, inputFile)
+	}
+
+	regenerate()
+	fmt.Fprintf(os.Stderr, "Watching %s for changes (Ctrl-C to stop)\n", inputFile)
+	if err := watchFile(ctx, inputFile, watchPollInterval, watchDebounce, regenerate); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Stopped watching %s\n", inputFile)
+	return nil
+}
+
+// watchFile polls path every interval and calls onChange once the file has
+// changed and then stayed unchanged for debounce. A file that is briefly
+// missing, as during an editor's atomic save, is not treated as a change.
+// watchFile returns nil when ctx is done.
+func watchFile(ctx context.Context, path string, interval, debounce time.Duration, onChange func()) error {
+	last, err := statFileStamp(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	debounceTimer := time.NewTimer(debounce)
+	debounceTimer.Stop()
+	defer debounceTimer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			stamp, err := statFileStamp(path)
+			if err != nil || stamp == last {
+				continue
+			}
+			last = stamp
+			debounceTimer.Reset(debounce)
+		case <-debounceTimer.C:
+			onChange()
+		}
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -20,6 +22,12 @@ var (
 	initVersioning  string
 	initConvention  string
 	initSkipInvalid bool
+	initVerbose     bool
+	initSinceVer    string
+	initTagPattern  string
+	initTagPrefix   string
+	initSinceDate   string
+	initUntilDate   string
 )
 
 var initCmd = &cobra.Command{
@@ -44,7 +52,19 @@ Examples:
   schangelog init --from-tags --project=myproject -o CHANGELOG.json
 
   # Set versioning and commit convention
-  schangelog init --from-tags --versioning=semver --convention=conventional`,
+  schangelog init --from-tags --versioning=semver --convention=conventional
+
+  # Backfill only tags from v1.0.0 onward, reporting progress to stderr
+  schangelog init --from-tags --since-version=v1.0.0 --verbose
+
+  # Backfill only tags dated within 2024
+  schangelog init --from-tags --since-date=2024-01-01 --until-date=2024-12-31
+
+  # Monorepo: only tags matching a glob pattern
+  schangelog init --from-tags --tag-pattern='sdk/go/*'
+
+  # Monorepo: tags like sdk/go/v1.0.0 become versions like v1.0.0
+  schangelog init --from-tags --tag-prefix=sdk/go/`,
 	RunE: runInit,
 }
 
@@ -56,6 +76,12 @@ func init() {
 	initCmd.Flags().StringVar(&initVersioning, "versioning", "semver", "Versioning scheme: semver, calver, custom, none")
 	initCmd.Flags().StringVar(&initConvention, "convention", "conventional", "Commit convention: conventional, none")
 	initCmd.Flags().BoolVar(&initSkipInvalid, "skip-invalid", false, "Skip tags that are not valid semver versions")
+	initCmd.Flags().BoolVarP(&initVerbose, "verbose", "v", false, "Report per-tag progress to stderr")
+	initCmd.Flags().StringVar(&initSinceVer, "since-version", "", "Skip tags older than this version (partial backfill)")
+	initCmd.Flags().StringVar(&initSinceDate, "since-date", "", "Skip tags dated before this date (YYYY-MM-DD)")
+	initCmd.Flags().StringVar(&initUntilDate, "until-date", "", "Skip tags dated after this date (YYYY-MM-DD, inclusive)")
+	initCmd.Flags().StringVar(&initTagPattern, "tag-pattern", "", "Only include tags matching this glob pattern (e.g., 'sdk/go/*')")
+	initCmd.Flags().StringVar(&initTagPrefix, "tag-prefix", "", "Only include tags with this prefix, stripped from versions (e.g., sdk/go/)")
 	rootCmd.AddCommand(initCmd)
 }
 
@@ -85,12 +111,37 @@ func runInitFromTags() error {
 		}
 	}
 
-	// Get all tags
-	tagList, err := gitlog.GetTags()
+	sinceDate, untilDate, err := parseInitDateRange(initSinceDate, initUntilDate)
+	if err != nil {
+		return err
+	}
+
+	// Get tags
+	var tagList *gitlog.TagList
+	switch {
+	case initTagPattern != "" && initTagPrefix != "":
+		return fmt.Errorf("--tag-pattern and --tag-prefix cannot be used together")
+	case initTagPattern != "":
+		tagList, err = gitlog.GetTagsByPattern(initTagPattern)
+	case initTagPrefix != "":
+		tagList, err = gitlog.GetTagsByPrefix(initTagPrefix)
+	default:
+		tagList, err = gitlog.GetTags()
+	}
 	if err != nil {
 		return fmt.Errorf("failed to get tags: %w", err)
 	}
 
+	// Pattern-matched tags like sdk/go/v1.0.0 use their last path segment as the version
+	if initTagPattern != "" {
+		for i, tag := range tagList.Tags {
+			if j := strings.LastIndex(tag.Name, "/"); j >= 0 {
+				tagList.Tags[i].Ref = tag.Name
+				tagList.Tags[i].Name = tag.Name[j+1:]
+			}
+		}
+	}
+
 	// Filter out invalid semver tags if --skip-invalid is set
 	var skippedTags []string
 	if initSkipInvalid {
@@ -113,28 +164,69 @@ func runInitFromTags() error {
 		return fmt.Errorf("no semver tags found in repository")
 	}
 
+	// Index of the oldest tag to include (--since-version)
+	start := 0
+	if initSinceVer != "" {
+		start = len(tagList.Tags)
+		for i, tag := range tagList.Tags {
+			if changelog.CompareSemVer(tag.Name, initSinceVer) >= 0 {
+				start = i
+				break
+			}
+		}
+		if start == len(tagList.Tags) {
+			return fmt.Errorf("no tags found at or after %s", initSinceVer)
+		}
+	}
+
+	// Tags to include, oldest first. Tags outside the date range are
+	// skipped but still bound the commit range of the next included tag.
+	var selected []int
+	for i := start; i < len(tagList.Tags); i++ {
+		if tagList.Tags[i].InDateRange(sinceDate, untilDate) {
+			selected = append(selected, i)
+		}
+	}
+	if len(selected) == 0 {
+		return fmt.Errorf("no tags found in the given date range")
+	}
+
 	// Create changelog structure
+	generatedAt := time.Now().UTC()
 	cl := &changelog.Changelog{
 		IRVersion:        "1.0",
 		Project:          projectName,
 		Repository:       repoURL,
 		Versioning:       initVersioning,
 		CommitConvention: initConvention,
-		Releases:         make([]changelog.Release, 0, len(tagList.Tags)),
+		GeneratedAt:      &generatedAt,
+		Source:           changelog.SourceGitTags,
+		Releases:         make([]changelog.Release, 0, len(selected)),
+	}
+
+	// A path-style prefix like "sdk/go/" maps to TagPath for compare links
+	if strings.HasSuffix(initTagPrefix, "/") {
+		cl.TagPath = strings.TrimSuffix(initTagPrefix, "/")
 	}
 
 	// Process each tag (in reverse order - newest first)
-	for i := len(tagList.Tags) - 1; i >= 0; i-- {
+	total := len(selected)
+	for _, i := range slices.Backward(selected) {
 		tag := tagList.Tags[i]
 
+		if initVerbose {
+			fmt.Fprintf(os.Stderr, "[%d/%d] processing tag %s (%d commits)\n",
+				len(cl.Releases)+1, total, tag.Name, tag.CommitCount)
+		}
+
 		// Determine since ref for parsing commits
 		var sinceRef string
 		if i > 0 {
-			sinceRef = tagList.Tags[i-1].Name
+			sinceRef = tagList.Tags[i-1].GitRef()
 		}
 
 		// Parse commits for this version
-		commits, err := parseCommitsForVersion(sinceRef, tag.Name)
+		commits, err := parseCommitsForVersion(sinceRef, tag.GitRef())
 		if err != nil {
 			// If we can't parse commits, create minimal release entry
 			cl.Releases = append(cl.Releases, changelog.Release{
@@ -168,6 +260,27 @@ func runInitFromTags() error {
 	return nil
 }
 
+// parseInitDateRange parses the --since-date and --until-date flags as UTC
+// dates. The until date is extended to the end of its day so that it is
+// inclusive. Empty values return a zero time, leaving the range open.
+func parseInitDateRange(since, until string) (from, to time.Time, err error) {
+	if since != "" {
+		if from, err = time.Parse(time.DateOnly, since); err != nil {
+			return from, to, fmt.Errorf("invalid --since-date %q (expected YYYY-MM-DD): %w", since, err)
+		}
+	}
+	if until != "" {
+		if to, err = time.Parse(time.DateOnly, until); err != nil {
+			return from, to, fmt.Errorf("invalid --until-date %q (expected YYYY-MM-DD): %w", until, err)
+		}
+		to = to.Add(24*time.Hour - time.Nanosecond)
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return from, to, fmt.Errorf("--until-date %s is before --since-date %s", until, since)
+	}
+	return from, to, nil
+}
+
 // parseCommitsForVersion parses commits between two refs.
 func parseCommitsForVersion(since, until string) ([]gitlog.Commit, error) {
 	var args []string
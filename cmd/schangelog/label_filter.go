@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/grokify/gogithub/auth"
+
+	"github.com/grokify/structured-changelog/changelog"
+	"github.com/grokify/structured-changelog/gitlog"
+)
+
+// applyLabelFilter overrides suggested categories from the GitHub labels of
+// the issues referenced by result's commits, using the mapping file at path.
+// The token falls back to the GITHUB_TOKEN environment variable.
+func applyLabelFilter(result *gitlog.ParseResult, path, token string) error {
+	lc, err := gitlog.LoadLabelCategories(path)
+	if err != nil {
+		return err
+	}
+
+	repoURL := result.Repository
+	if !strings.Contains(repoURL, "://") {
+		// getRepositoryURL returns "github.com/owner/repo" without a scheme
+		repoURL = "https://" + repoURL
+	}
+	parsed := changelog.ParseRepoURL(repoURL)
+	owner, repo, _ := strings.Cut(parsed.Path, "/")
+	if parsed.Host != changelog.RepoHostGitHub {
+		return fmt.Errorf("--label-filter requires a GitHub repository (use --repo), got %q", result.Repository)
+	}
+
+	if token == "" {
+		token = os.Getenv("GITHUB_TOKEN")
+	}
+	if token == "" {
+		return fmt.Errorf("--label-filter requires --github-token or the GITHUB_TOKEN environment variable")
+	}
+
+	ctx := context.Background()
+	client, err := auth.NewGitHubClient(ctx, token)
+	if err != nil {
+		return fmt.Errorf("creating GitHub client: %w", err)
+	}
+
+	issueLabels := make(map[int][]string)
+	for _, c := range result.Commits {
+		if c.Issue == 0 {
+			continue
+		}
+		if _, ok := issueLabels[c.Issue]; ok {
+			continue
+		}
+		issue, _, err := client.Issues.Get(ctx, owner, repo, c.Issue)
+		if err != nil {
+			return fmt.Errorf("failed to fetch labels for issue #%d: %w", c.Issue, err)
+		}
+		labels := []string{}
+		for _, l := range issue.Labels {
+			labels = append(labels, l.GetName())
+		}
+		issueLabels[c.Issue] = labels
+	}
+
+	result.ApplyLabelCategories(issueLabels, lc)
+	return nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+var (
+	lintRules     []string
+	lintSkipRules []string
+)
+
+var lintCmd = &cobra.Command{
+	Use:   "lint <file>",
+	Short: "Check a CHANGELOG.json file for style issues",
+	Long: `Run opinionated style checks against a CHANGELOG.json file.
+
+Unlike validate, which checks correctness against the IR schema, lint
+enforces writing style. Violations are reported with the same path
+notation as validate --format (e.g., releases[0].added[1].description).
+
+Rules:
+  capitalized          Entries should start with a capital letter
+  no-trailing-period   Entries should not end with a period
+  no-first-person      Entries should not begin with "I "
+  security-advisory    Security entries should have a CVE or GHSA
+  added-or-fixed       Non-maintenance releases should have an Added or Fixed entry
+
+All rules run by default. Use --rule to run only specific rules, or
+--skip-rule to disable specific rules. Both flags are repeatable.
+
+Examples:
+  schangelog lint CHANGELOG.json
+  schangelog lint CHANGELOG.json --rule=capitalized --rule=no-trailing-period
+  schangelog lint CHANGELOG.json --skip-rule=added-or-fixed`,
+	Args: cobra.ExactArgs(1),
+	RunE: runLint,
+}
+
+func init() {
+	lintCmd.Flags().StringArrayVar(&lintRules, "rule", nil, "Run only this rule (repeatable)")
+	lintCmd.Flags().StringArrayVar(&lintSkipRules, "skip-rule", nil, "Skip this rule (repeatable)")
+	rootCmd.AddCommand(lintCmd)
+}
+
+func runLint(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+
+	rules, err := selectLintRules(lintRules, lintSkipRules)
+	if err != nil {
+		return err
+	}
+
+	cl, err := changelog.LoadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", inputFile, err)
+	}
+
+	violations := cl.Lint(rules)
+	if len(violations) > 0 {
+		fmt.Fprintf(os.Stderr, "Lint failed for %s:\n", inputFile)
+		for _, v := range violations {
+			fmt.Fprintf(os.Stderr, "  ✗ %s: %s [%s]\n", v.Path, v.Message, v.Rule)
+		}
+		return fmt.Errorf("lint failed with %d violation(s)", len(violations))
+	}
+
+	fmt.Printf("✓ %s passes lint\n", inputFile)
+	return nil
+}
+
+// selectLintRules returns the rules to run: the named rules if any are given,
+// otherwise all rules, minus any skipped rules.
+func selectLintRules(only, skip []string) ([]changelog.LintRule, error) {
+	rules := changelog.LintRules
+	if len(only) > 0 {
+		rules = nil
+		for _, name := range only {
+			rule, err := changelog.ParseLintRule(name)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rule)
+		}
+	}
+
+	var skipped []changelog.LintRule
+	for _, name := range skip {
+		rule, err := changelog.ParseLintRule(name)
+		if err != nil {
+			return nil, err
+		}
+		skipped = append(skipped, rule)
+	}
+
+	var selected []changelog.LintRule
+	for _, rule := range rules {
+		if !slices.Contains(skipped, rule) {
+			selected = append(selected, rule)
+		}
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("no lint rules selected")
+	}
+	return selected, nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+	"github.com/grokify/structured-changelog/format"
+)
+
+var (
+	listVersionsFormat            string
+	listVersionsIncludeUnreleased bool
+	listVersionsSemVerOnly        bool
+	listVersionsFilterMaintenance bool
+)
+
+var listVersionsCmd = &cobra.Command{
+	Use:   "list-versions <file>",
+	Short: "Print the versions in a changelog, newest first",
+	Long: `Print the release versions in a Structured Changelog JSON file, one
+per line, newest first.
+
+Output formats (with --format flag):
+  text          One version per line (default)
+  json          JSON string array
+  json-compact  Minified JSON string array
+  toon          Token-Oriented Object Notation
+
+Examples:
+  schangelog list-versions CHANGELOG.json
+  schangelog list-versions CHANGELOG.json --include-unreleased --semver-only
+  schangelog list-versions CHANGELOG.json --filter-maintenance --format=json
+
+  # Loop over versions in a shell script
+  for v in $(schangelog list-versions CHANGELOG.json); do echo "$v"; done`,
+	Args: cobra.ExactArgs(1),
+	RunE: runListVersions,
+}
+
+func init() {
+	listVersionsCmd.Flags().StringVar(&listVersionsFormat, "format", "text", "Output format: text, json, json-compact, toon")
+	listVersionsCmd.Flags().BoolVar(&listVersionsIncludeUnreleased, "include-unreleased", false, "List \""+changelog.UnreleasedVersion+"\" first if there is an Unreleased section")
+	listVersionsCmd.Flags().BoolVar(&listVersionsSemVerOnly, "semver-only", false, "Omit versions that are not valid semantic versions")
+	listVersionsCmd.Flags().BoolVar(&listVersionsFilterMaintenance, "filter-maintenance", false, "Omit maintenance-only releases")
+	rootCmd.AddCommand(listVersionsCmd)
+}
+
+func runListVersions(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+
+	cl, err := changelog.LoadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", inputFile, err)
+	}
+
+	versions := listVersions(cl, listVersionsIncludeUnreleased, listVersionsSemVerOnly, listVersionsFilterMaintenance)
+
+	if listVersionsFormat == "text" {
+		for _, v := range versions {
+			fmt.Println(v)
+		}
+		return nil
+	}
+
+	f, err := format.Parse(listVersionsFormat)
+	if err != nil {
+		return err
+	}
+	output, err := format.Marshal(versions, f)
+	if err != nil {
+		return fmt.Errorf("failed to marshal output: %w", err)
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+// listVersions returns the release versions of cl in changelog order,
+// optionally preceded by the Unreleased section and filtered to semantic
+// versions or non-maintenance releases.
+func listVersions(cl *changelog.Changelog, includeUnreleased, semverOnly, filterMaintenance bool) []string {
+	versions := []string{}
+	if includeUnreleased && cl.Unreleased != nil {
+		versions = append(versions, changelog.UnreleasedVersion)
+	}
+	for i := range cl.Releases {
+		r := &cl.Releases[i]
+		if semverOnly && !changelog.IsValidSemVer(r.Version) {
+			continue
+		}
+		if filterMaintenance && r.IsMaintenanceOnly() {
+			continue
+		}
+		versions = append(versions, r.Version)
+	}
+	return versions
+}
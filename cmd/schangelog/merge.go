@@ -15,6 +15,7 @@ var (
 	mergeRelease     string
 	mergeDedup       bool
 	mergePrependOnly bool
+	mergeProject     string
 )
 
 var mergeCmd = &cobra.Command{
@@ -41,7 +42,14 @@ Examples:
   schangelog merge CHANGELOG.json --release new-release.json -o CHANGELOG.json
 
   # Merge with deduplication (skip versions that already exist in base)
-  schangelog merge base.json additions.json --dedup -o CHANGELOG.json`,
+  schangelog merge base.json additions.json --dedup -o CHANGELOG.json
+
+  # Combine per-package changelogs into one (monorepo)
+  schangelog merge api/CHANGELOG.json sdk/CHANGELOG.json --project=mono -o combined.json
+
+With --project, all files are combined as peers instead of into a base:
+releases are sorted by date (newest first), entries of releases with the
+same version are merged, and the result's project name is set to --project.`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: runMerge,
 }
@@ -51,10 +59,15 @@ func init() {
 	mergeCmd.Flags().StringVar(&mergeRelease, "release", "", "Single release file to prepend")
 	mergeCmd.Flags().BoolVar(&mergeDedup, "dedup", false, "Skip versions that already exist in base")
 	mergeCmd.Flags().BoolVar(&mergePrependOnly, "prepend-only", false, "Only add releases newer than base's latest")
+	mergeCmd.Flags().StringVar(&mergeProject, "project", "", "Combine all files as peers into a changelog with this project name")
 	rootCmd.AddCommand(mergeCmd)
 }
 
 func runMerge(cmd *cobra.Command, args []string) error {
+	if mergeProject != "" {
+		return runMergeCombine(args)
+	}
+
 	// Load base changelog
 	basePath := args[0]
 	base, err := changelog.LoadFile(basePath)
@@ -118,8 +131,31 @@ func runMerge(cmd *cobra.Command, args []string) error {
 		fmt.Fprintf(os.Stderr, "Skipped %d duplicate versions: %v\n", len(duplicates), duplicates)
 	}
 
+	return writeMergeOutput(base)
+}
+
+// runMergeCombine combines all changelog files as peers (e.g., monorepo packages).
+func runMergeCombine(paths []string) error {
+	if mergeRelease != "" || mergeDedup || mergePrependOnly {
+		return fmt.Errorf("--project cannot be combined with --release, --dedup, or --prepend-only")
+	}
+
+	sources := make([]*changelog.Changelog, 0, len(paths))
+	for _, path := range paths {
+		cl, err := changelog.LoadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to load changelog %s: %w", path, err)
+		}
+		sources = append(sources, cl)
+	}
+
+	return writeMergeOutput(changelog.MergeChangelogs(mergeProject, sources...))
+}
+
+// writeMergeOutput writes the merged changelog to --output or stdout.
+func writeMergeOutput(cl *changelog.Changelog) error {
 	// Marshal to JSON
-	output, err := json.MarshalIndent(base, "", "  ")
+	output, err := json.MarshalIndent(cl, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal changelog: %w", err)
 	}
@@ -129,7 +165,7 @@ func runMerge(cmd *cobra.Command, args []string) error {
 		if err := os.WriteFile(mergeOutput, output, 0600); err != nil {
 			return fmt.Errorf("failed to write output file: %w", err)
 		}
-		fmt.Fprintf(os.Stderr, "Merged changelog written to %s (%d releases)\n", mergeOutput, len(base.Releases))
+		fmt.Fprintf(os.Stderr, "Merged changelog written to %s (%d releases)\n", mergeOutput, len(cl.Releases))
 	} else {
 		fmt.Println(string(output))
 	}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+var (
+	migrateOutput string
+	migrateDryRun bool
+	migrateBackup bool
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate <file>",
+	Short: "Extract structured metadata from entry descriptions",
+	Long: `Upgrade a CHANGELOG.json written with description-only entries by
+extracting metadata from the free text of each entry.
+
+Extracted fields (only populated when empty):
+  pr       Trailing "(#123)" references
+  issue    Other "#123" references
+  author   First "@username" mention
+  cve      CVE identifiers (CVE-YYYY-NNNNN)
+
+Descriptions and fields that are already set are never modified. The
+file is updated in place unless --output or --dry-run is given. With
+--backup, the existing file is first copied to <file>.bak and restored
+if the write fails.
+
+Examples:
+  schangelog migrate CHANGELOG.json
+  schangelog migrate CHANGELOG.json -o CHANGELOG.migrated.json
+  schangelog migrate CHANGELOG.json --dry-run
+  schangelog migrate CHANGELOG.json --backup`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMigrate,
+}
+
+func init() {
+	migrateCmd.Flags().StringVarP(&migrateOutput, "output", "o", "", "Output file (default: overwrite input)")
+	migrateCmd.Flags().BoolVar(&migrateDryRun, "dry-run", false, "Print the migrated changelog to stdout without writing")
+	migrateCmd.Flags().BoolVar(&migrateBackup, "backup", false, "Copy the existing output file to <file>.bak before writing")
+	rootCmd.AddCommand(migrateCmd)
+}
+
+func runMigrate(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+
+	cl, err := changelog.LoadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", inputFile, err)
+	}
+
+	migrated := cl.MigrateEntries()
+
+	if migrateDryRun {
+		output, err := cl.JSON()
+		if err != nil {
+			return fmt.Errorf("failed to marshal changelog: %w", err)
+		}
+		fmt.Println(string(output))
+		fmt.Fprintf(os.Stderr, "%d entries would be migrated\n", migrated)
+		return nil
+	}
+
+	outputFile := migrateOutput
+	if outputFile == "" {
+		outputFile = inputFile
+	}
+	write := cl.WriteFile
+	if migrateBackup {
+		write = cl.WriteFileWithBackup
+	}
+	if err := write(outputFile); err != nil {
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Migrated %d entries, written to %s\n", migrated, outputFile)
+	return nil
+}
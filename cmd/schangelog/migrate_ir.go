@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+var (
+	migrateIRTo     string
+	migrateIROutput string
+)
+
+var migrateIRCmd = &cobra.Command{
+	Use:   "migrate-ir <file>",
+	Short: "Upgrade a changelog to a newer IR version",
+	Long: `Upgrade a Structured Changelog JSON file to a newer IR schema version,
+applying the structural changes of each intermediate version.
+
+Supported IR versions: ` + strings.Join(changelog.SupportedIRVersions, ", ") + `
+
+The file is updated in place unless --output is given. Downgrades are
+not supported.
+
+Examples:
+  schangelog migrate-ir --to=1.1 CHANGELOG.json
+  schangelog migrate-ir --to=1.1 CHANGELOG.json -o CHANGELOG.v1.1.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runMigrateIR,
+}
+
+func init() {
+	migrateIRCmd.Flags().StringVar(&migrateIRTo, "to", "", "Target IR version (required)")
+	migrateIRCmd.Flags().StringVarP(&migrateIROutput, "output", "o", "", "Output file (default: overwrite input)")
+	_ = migrateIRCmd.MarkFlagRequired("to")
+	rootCmd.AddCommand(migrateIRCmd)
+}
+
+func runMigrateIR(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+
+	cl, err := changelog.LoadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", inputFile, err)
+	}
+
+	from := cl.IRVersion
+	if err := cl.MigrateIRVersion(migrateIRTo); err != nil {
+		return err
+	}
+
+	outputFile := migrateIROutput
+	if outputFile == "" {
+		outputFile = inputFile
+	}
+	if err := cl.WriteFile(outputFile); err != nil {
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Migrated IR version %s to %s, written to %s\n", from, cl.IRVersion, outputFile)
+	return nil
+}
@@ -1,7 +1,10 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"strings"
 
@@ -23,6 +26,18 @@ var (
 	parseCommitsRepoURL     string
 	parseCommitsChangelog   string
 	parseCommitsAllVersions bool
+	parseCommitsBranch      string
+	parseCommitsBase        string
+	parseCommitsOverrides   []string
+	parseCommitsTrailers    []string
+	parseCommitsSuggestBump bool
+	parseCommitsOutputFile  string
+	parseCommitsAppend      bool
+	parseCommitsSinceLast   bool
+	parseCommitsVerbose     bool
+	parseCommitsEmailMap    string
+	parseCommitsLabelFilter string
+	parseCommitsGitHubToken string
 )
 
 var parseCommitsCmd = &cobra.Command{
@@ -43,6 +58,7 @@ The output includes:
   - Suggested changelog categories based on commit type
   - File statistics (insertions, deletions, files changed)
   - Issue and PR references extracted from messages
+  - Commit trailers selected with --trailers, such as Closes
   - Summary statistics grouped by type and category
 
 Examples:
@@ -52,6 +68,9 @@ Examples:
   # Parse commits with JSON output
   schangelog parse-commits --since=v0.3.0 --format=json
 
+  # Parse commits since the newest semver tag
+  schangelog parse-commits --since-last-tag
+
   # Parse commits between two refs
   schangelog parse-commits --since=v0.2.0 --until=v0.3.0
 
@@ -70,16 +89,39 @@ Examples:
   # Mark external contributors (reads maintainers/bots from CHANGELOG.json)
   schangelog parse-commits --since=v0.3.0 --changelog=CHANGELOG.json
 
+  # Treat corporate emails of maintainers as team members
+  schangelog parse-commits --since=v0.3.0 --changelog=CHANGELOG.json --author-email-map=emails.json
+
   # Parse all commits from the beginning of the repository to a tag
   schangelog parse-commits --until=v0.1.0
 
   # Parse commits for ALL version ranges at once (useful for backfilling)
-  schangelog parse-commits --all-versions`,
+  schangelog parse-commits --all-versions
+
+  # Parse commits a feature branch adds on top of main (PR preview)
+  schangelog parse-commits --branch=feature/x --base=main --changelog=CHANGELOG.json
+
+  # Write to a file, accumulating commits across runs
+  schangelog parse-commits --since=v0.3.0 --format=json --output-file=commits.json --append
+
+  # Map custom commit types to changelog categories
+  schangelog parse-commits --since=v0.3.0 --category-override story:Added --category-override spike:Internal
+
+  # Use GitHub issue labels as the category source (needs a token)
+  schangelog parse-commits --since=v0.3.0 --label-filter=labels.json --github-token=$GITHUB_TOKEN
+
+  # Capture selected commit trailers
+  schangelog parse-commits --since=v0.3.0 --trailers=Closes,Jira-Issue
+
+  # Suggest whether the next release is major, minor, or patch
+  schangelog parse-commits --since=v0.3.0 --suggest-version-bump`,
 	RunE: runParseCommits,
 }
 
 func init() {
 	parseCommitsCmd.Flags().StringVar(&parseCommitsSince, "since", "", "Parse commits after this ref (tag, branch, or commit)")
+	parseCommitsCmd.Flags().BoolVar(&parseCommitsSinceLast, "since-last-tag", false, "Parse commits after the newest semver tag (all commits if there are no tags)")
+	parseCommitsCmd.Flags().BoolVarP(&parseCommitsVerbose, "verbose", "v", false, "Report the resolved --since-last-tag tag to stderr")
 	parseCommitsCmd.Flags().StringVar(&parseCommitsUntil, "until", "HEAD", "Parse commits up to this ref (default: HEAD)")
 	parseCommitsCmd.Flags().IntVar(&parseCommitsLast, "last", 0, "Parse last N commits (alternative to --since)")
 	parseCommitsCmd.Flags().StringVar(&parseCommitsPath, "path", "", "Only include commits touching this path")
@@ -88,32 +130,64 @@ func init() {
 	parseCommitsCmd.Flags().StringVar(&parseCommitsFormat, "format", "toon", "Output format: toon (default), json, json-compact")
 	parseCommitsCmd.Flags().StringVar(&parseCommitsRepoURL, "repo", "", "Repository URL to include in output")
 	parseCommitsCmd.Flags().StringVar(&parseCommitsChangelog, "changelog", "", "CHANGELOG.json to read maintainers/bots for external contributor detection")
+	parseCommitsCmd.Flags().StringVar(&parseCommitsEmailMap, "author-email-map", "", "JSON file mapping author emails to GitHub usernames, merged into --changelog maintainers")
 	parseCommitsCmd.Flags().BoolVar(&parseCommitsAllVersions, "all-versions", false, "Parse commits for all version ranges (outputs array of results)")
+	parseCommitsCmd.Flags().StringVar(&parseCommitsBranch, "branch", "", "Parse commits on this branch that are not on --base")
+	parseCommitsCmd.Flags().StringVar(&parseCommitsBase, "base", "main", "Base branch for --branch (default: main)")
+	parseCommitsCmd.Flags().StringVar(&parseCommitsOutputFile, "output-file", "", "Write output to this file instead of stdout")
+	parseCommitsCmd.Flags().BoolVar(&parseCommitsAppend, "append", false, "Merge commits into an existing --output-file (deduplicated by hash)")
+	parseCommitsCmd.Flags().StringArrayVar(&parseCommitsOverrides, "category-override", nil, "Map a commit type to a category as type:Category (repeatable)")
+	parseCommitsCmd.Flags().StringVar(&parseCommitsLabelFilter, "label-filter", "", "JSON file mapping GitHub issue labels to categories, e.g. {\"type: bug\": \"Fixed\"}")
+	parseCommitsCmd.Flags().StringVar(&parseCommitsGitHubToken, "github-token", "", "GitHub token for --label-filter (default: GITHUB_TOKEN environment variable)")
+	parseCommitsCmd.Flags().BoolVar(&parseCommitsSuggestBump, "suggest-version-bump", false, "Include a suggested release type (major, minor, patch) in the output")
+	parseCommitsCmd.Flags().StringSliceVar(&parseCommitsTrailers, "trailers", nil, "Commit trailer keys to capture (e.g., Closes,Jira-Issue)")
 	rootCmd.AddCommand(parseCommitsCmd)
 }
 
 func runParseCommits(cmd *cobra.Command, args []string) error {
-	// Handle --all-versions mode
-	if parseCommitsAllVersions {
-		return runParseAllVersions()
+	if parseCommitsAppend && parseCommitsOutputFile == "" {
+		return fmt.Errorf("--append requires --output-file")
 	}
 
-	// Build git log command
-	gitArgs := buildGitLogArgs()
-
-	// Run git log
-	output, err := runGitLog(gitArgs)
-	if err != nil {
-		return err
+	if parseCommitsSinceLast {
+		if parseCommitsSince != "" || parseCommitsLast > 0 || parseCommitsBranch != "" || parseCommitsAllVersions {
+			return fmt.Errorf("--since-last-tag cannot be combined with --since, --last, --branch, or --all-versions")
+		}
+		if err := resolveSinceLastTag(); err != nil {
+			return err
+		}
 	}
 
-	// Parse output
-	parser := gitlog.NewParser()
-	parser.IncludeFiles = !parseCommitsNoFiles
+	// Handle --all-versions mode
+	if parseCommitsAllVersions {
+		if parseCommitsAppend {
+			return fmt.Errorf("--append cannot be combined with --all-versions")
+		}
+		if parseCommitsLabelFilter != "" {
+			return fmt.Errorf("--label-filter cannot be combined with --all-versions")
+		}
+		return runParseAllVersions()
+	}
 
-	result, err := parser.Parse(output)
-	if err != nil {
-		return fmt.Errorf("failed to parse git log output: %w", err)
+	var result *gitlog.ParseResult
+	var err error
+	if parseCommitsBranch != "" {
+		if parseCommitsSince != "" || parseCommitsLast > 0 {
+			return fmt.Errorf("--branch cannot be combined with --since or --last")
+		}
+		parser, err := newCommitParser()
+		if err != nil {
+			return err
+		}
+		result, err = parser.GetBranchCommits(parseCommitsBranch, parseCommitsBase)
+		if err != nil {
+			return fmt.Errorf("failed to get branch commits: %w", err)
+		}
+	} else {
+		result, err = parseCommitRange()
+		if err != nil {
+			return err
+		}
 	}
 
 	// Set metadata
@@ -126,8 +200,12 @@ func runParseCommits(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	result.Range.Since = parseCommitsSince
-	result.Range.Until = parseCommitsUntil
+	// Override categories from GitHub issue labels
+	if parseCommitsLabelFilter != "" {
+		if err := applyLabelFilter(result, parseCommitsLabelFilter, parseCommitsGitHubToken); err != nil {
+			return err
+		}
+	}
 
 	// If no-files flag, clear file lists from commits
 	if parseCommitsNoFiles {
@@ -137,12 +215,9 @@ func runParseCommits(cmd *cobra.Command, args []string) error {
 	}
 
 	// Load changelog for external contributor detection
-	var cl *changelog.Changelog
-	if parseCommitsChangelog != "" {
-		cl, err = changelog.LoadFile(parseCommitsChangelog)
-		if err != nil {
-			return fmt.Errorf("failed to load changelog %s: %w", parseCommitsChangelog, err)
-		}
+	cl, err := loadTeamChangelog()
+	if err != nil {
+		return err
 	}
 
 	// Mark external contributors
@@ -163,16 +238,155 @@ func runParseCommits(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if parseCommitsAppend {
+		result, err = mergeExistingParseResult(parseCommitsOutputFile, f, result)
+		if err != nil {
+			return err
+		}
+	}
+
+	if parseCommitsSuggestBump {
+		result.SuggestedReleaseType = result.SuggestReleaseType()
+	}
+
 	// Output in specified format
 	outputBytes, err := format.Marshal(result, f)
 	if err != nil {
 		return fmt.Errorf("failed to marshal output: %w", err)
 	}
 
-	fmt.Println(string(outputBytes))
+	return writeParseCommitsOutput(outputBytes)
+}
+
+// mergeExistingParseResult loads the ParseResult previously written to path
+// in format f and merges result into it. If path does not exist, result is
+// returned unchanged.
+func mergeExistingParseResult(path string, f format.Format, result *gitlog.ParseResult) (*gitlog.ParseResult, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return result, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	existing := gitlog.NewParseResult()
+	if err := format.Unmarshal(data, f, existing); err != nil {
+		return nil, fmt.Errorf("failed to parse existing %s: %w", path, err)
+	}
+	return existing.Merge(result), nil
+}
+
+// writeParseCommitsOutput writes output to --output-file, or to stdout
+// if none is set.
+func writeParseCommitsOutput(output []byte) error {
+	if parseCommitsOutputFile == "" {
+		fmt.Println(string(output))
+		return nil
+	}
+	if err := os.WriteFile(parseCommitsOutputFile, append(output, '\n'), 0644); err != nil { //nolint:gosec // 0644 intentional for readable output
+		return fmt.Errorf("failed to write output file: %w", err)
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %s\n", parseCommitsOutputFile)
+	return nil
+}
+
+// parseCommitRange runs git log for the --since/--until/--last flags and parses the output.
+func parseCommitRange() (*gitlog.ParseResult, error) {
+	output, err := runGitLog(buildGitLogArgs())
+	if err != nil {
+		return nil, err
+	}
+
+	parser, err := newCommitParser()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := parser.Parse(output)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse git log output: %w", err)
+	}
+
+	result.Range.Since = parseCommitsSince
+	result.Range.Until = parseCommitsUntil
+	return result, nil
+}
+
+// loadTeamChangelog loads the --changelog file used for external contributor
+// detection, adding the maintainer emails from --author-email-map. Returns
+// nil if --changelog is not set.
+func loadTeamChangelog() (*changelog.Changelog, error) {
+	if parseCommitsChangelog == "" {
+		if parseCommitsEmailMap != "" {
+			return nil, fmt.Errorf("--author-email-map requires --changelog")
+		}
+		return nil, nil
+	}
+
+	cl, err := changelog.LoadFile(parseCommitsChangelog)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load changelog %s: %w", parseCommitsChangelog, err)
+	}
+
+	if parseCommitsEmailMap != "" {
+		data, err := os.ReadFile(parseCommitsEmailMap)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read author email map: %w", err)
+		}
+		var emailMap map[string]string
+		if err := json.Unmarshal(data, &emailMap); err != nil {
+			return nil, fmt.Errorf("failed to parse author email map %s: %w", parseCommitsEmailMap, err)
+		}
+		cl.AddMaintainerEmails(emailMap)
+	}
+	return cl, nil
+}
+
+// resolveSinceLastTag sets parseCommitsSince to the newest semver tag. If
+// there are no tags, it is left empty so that all commits are parsed.
+func resolveSinceLastTag() error {
+	tagList, err := gitlog.GetTags()
+	if err != nil {
+		return fmt.Errorf("failed to get tags: %w", err)
+	}
+	if len(tagList.Tags) == 0 {
+		if parseCommitsVerbose {
+			fmt.Fprintln(os.Stderr, "no tags found, parsing all commits")
+		}
+		return nil
+	}
+
+	// Tags are sorted oldest first
+	parseCommitsSince = tagList.Tags[len(tagList.Tags)-1].GitRef()
+	if parseCommitsVerbose {
+		fmt.Fprintf(os.Stderr, "parsing commits since %s\n", parseCommitsSince)
+	}
 	return nil
 }
 
+// newCommitParser returns a git log parser configured from the
+// --no-files, --trailers, and --category-override flags.
+func newCommitParser() (*gitlog.Parser, error) {
+	parser := gitlog.NewParser()
+	parser.IncludeFiles = !parseCommitsNoFiles
+	parser.SetTrailerKeys(parseCommitsTrailers...)
+
+	if len(parseCommitsOverrides) > 0 {
+		overrides := make(map[string]string, len(parseCommitsOverrides))
+		for _, o := range parseCommitsOverrides {
+			commitType, category, ok := strings.Cut(o, ":")
+			if !ok || commitType == "" || category == "" {
+				return nil, fmt.Errorf("invalid --category-override %q: expected type:Category", o)
+			}
+			overrides[commitType] = category
+		}
+		if err := parser.SetCategoryOverrides(overrides); err != nil {
+			return nil, fmt.Errorf("invalid --category-override: %w", err)
+		}
+	}
+	return parser, nil
+}
+
 func buildGitLogArgs() []string {
 	args := []string{
 		"log",
@@ -251,6 +465,8 @@ type VersionParseResult struct {
 	CommitCount int             `json:"commitCount"`
 	Commits     []gitlog.Commit `json:"commits"`
 	Summary     gitlog.Summary  `json:"summary"`
+
+	SuggestedReleaseType string `json:"suggestedReleaseType,omitempty"`
 }
 
 // runParseAllVersions parses commits for all version ranges at once.
@@ -274,12 +490,14 @@ func runParseAllVersions() error {
 	}
 
 	// Load changelog for external contributor detection
-	var cl *changelog.Changelog
-	if parseCommitsChangelog != "" {
-		cl, err = changelog.LoadFile(parseCommitsChangelog)
-		if err != nil {
-			return fmt.Errorf("failed to load changelog %s: %w", parseCommitsChangelog, err)
-		}
+	cl, err := loadTeamChangelog()
+	if err != nil {
+		return err
+	}
+
+	parser, err := newCommitParser()
+	if err != nil {
+		return err
 	}
 
 	// Parse commits for each version
@@ -309,9 +527,6 @@ func runParseAllVersions() error {
 			continue
 		}
 
-		parser := gitlog.NewParser()
-		parser.IncludeFiles = !parseCommitsNoFiles
-
 		parseResult, err := parser.Parse(output)
 		if err != nil {
 			continue
@@ -340,6 +555,9 @@ func runParseAllVersions() error {
 			Commits:     parseResult.Commits,
 			Summary:     parseResult.Summary,
 		}
+		if parseCommitsSuggestBump {
+			vpr.SuggestedReleaseType = parseResult.SuggestReleaseType()
+		}
 
 		result.Versions = append(result.Versions, vpr)
 		totalCommits += len(parseResult.Commits)
@@ -359,6 +577,5 @@ func runParseAllVersions() error {
 		return fmt.Errorf("failed to marshal output: %w", err)
 	}
 
-	fmt.Println(string(outputBytes))
-	return nil
+	return writeParseCommitsOutput(outputBytes)
 }
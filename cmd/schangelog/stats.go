@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+	"github.com/grokify/structured-changelog/format"
+)
+
+var statsFormat string
+
+var statsCmd = &cobra.Command{
+	Use:   "stats <file>",
+	Short: "Show statistics for a CHANGELOG.json file",
+	Long: `Show analytics for a Structured Changelog JSON file.
+
+Statistics include:
+  - Total releases and date range
+  - Most common categories
+  - Average entries per release
+  - Security issues and breaking changes
+  - External contributor count
+  - Notable vs. maintenance release ratio
+
+Output formats (with --format flag):
+  - toon: Token-Oriented Object Notation, ~40% fewer tokens than JSON
+  - json: Standard JSON with indentation
+  - json-compact: Minified JSON
+
+Examples:
+  schangelog stats CHANGELOG.json
+  schangelog stats CHANGELOG.json --format=json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runStats,
+}
+
+func init() {
+	statsCmd.Flags().StringVar(&statsFormat, "format", "", "Output format: toon, json, json-compact (default: human-readable text)")
+	rootCmd.AddCommand(statsCmd)
+}
+
+func runStats(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+
+	cl, err := changelog.LoadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", inputFile, err)
+	}
+
+	stats := cl.ComputeStatistics()
+
+	if statsFormat != "" {
+		f, err := format.Parse(statsFormat)
+		if err != nil {
+			return err
+		}
+		output, err := format.Marshal(stats, f)
+		if err != nil {
+			return fmt.Errorf("failed to marshal output: %w", err)
+		}
+		fmt.Println(string(output))
+		return nil
+	}
+
+	printStatistics(cl.Project, stats)
+	return nil
+}
+
+func printStatistics(project string, s changelog.Statistics) {
+	fmt.Printf("Statistics for %s:\n", project)
+	fmt.Printf("  Releases: %d\n", s.TotalReleases)
+	if s.FirstReleaseDate != "" {
+		fmt.Printf("  Date range: %s to %s\n", s.FirstReleaseDate, s.LatestReleaseDate)
+	}
+	fmt.Printf("  Entries: %d (%.1f per release)\n", s.TotalEntries, s.AverageEntriesPerRelease)
+	fmt.Printf("  Security issues: %d\n", s.SecurityIssues)
+	fmt.Printf("  Breaking changes: %d\n", s.BreakingChanges)
+	fmt.Printf("  External contributors: %d\n", s.ExternalContributors)
+	fmt.Printf("  Notable releases: %d\n", s.NotableReleases)
+	fmt.Printf("  Maintenance releases: %d (%.0f%%)\n", s.MaintenanceReleases, s.MaintenanceRatio*100)
+
+	if len(s.Categories) > 0 {
+		fmt.Printf("\nCategories:\n")
+		for _, cat := range s.Categories {
+			fmt.Printf("  %-16s %d\n", cat.Name, cat.Count)
+		}
+	}
+}
@@ -110,12 +110,18 @@ func suggestForMessage(message string) SuggestCategoryOutput {
 	}
 
 	// Get primary suggestion
-	if suggestion := gitlog.SuggestCategoryFromMessage(message); suggestion != nil {
+	suggestion, alternatives := gitlog.SuggestCategoryFromMessage(message)
+	if suggestion != nil {
 		output.Suggestions = append(output.Suggestions, *suggestion)
 
-		// Add alternative suggestions for ambiguous cases
-		alternatives := getAlternativeSuggestions(message, suggestion.Category)
-		output.Suggestions = append(output.Suggestions, alternatives...)
+		// Add alternative suggestions for multi-concern and ambiguous cases
+		seen := map[string]bool{suggestion.Category: true}
+		for _, alt := range append(alternatives, getAlternativeSuggestions(message, suggestion.Category)...) {
+			if !seen[alt.Category] {
+				seen[alt.Category] = true
+				output.Suggestions = append(output.Suggestions, alt)
+			}
+		}
 	}
 
 	return output
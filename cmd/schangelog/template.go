@@ -0,0 +1,107 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+var (
+	templateTier         string
+	templateWithSecurity bool
+	templateOutput       string
+)
+
+var templateCmd = &cobra.Command{
+	Use:   "template",
+	Short: "Generate an example CHANGELOG.json to start from",
+	Long: `Generate a skeleton Structured Changelog JSON file with an Unreleased
+section containing one example entry per category in the selected tier.
+
+Tiers (with --tier flag):
+  core       KACL standard types (default)
+  standard   core + Highlights, Breaking, Upgrade Guide, Performance, Dependencies
+  extended   standard + Documentation, Build, Known Issues, Contributors
+  full       All types
+
+Use --with-security to fill the Security entry with example CVE, GHSA,
+severity, and CVSS values.
+
+Examples:
+  schangelog template > CHANGELOG.json
+  schangelog template --tier=standard --with-security
+  schangelog template --tier=full -o CHANGELOG.json`,
+	Args: cobra.NoArgs,
+	RunE: runTemplate,
+}
+
+func init() {
+	templateCmd.Flags().StringVar(&templateTier, "tier", "core", "Categories to include: core, standard, extended, full")
+	templateCmd.Flags().BoolVar(&templateWithSecurity, "with-security", false, "Populate the Security entry with example CVE, GHSA, and CVSS fields")
+	templateCmd.Flags().StringVarP(&templateOutput, "output", "o", "", "Output file (default: stdout)")
+	rootCmd.AddCommand(templateCmd)
+}
+
+func runTemplate(cmd *cobra.Command, args []string) error {
+	tier := changelog.TierOptional
+	if templateTier != "full" {
+		var err error
+		tier, err = changelog.ParseTier(templateTier)
+		if err != nil {
+			return fmt.Errorf("invalid tier %q: must be one of core, standard, extended, full", templateTier)
+		}
+	}
+
+	cl, err := buildTemplateChangelog(tier, templateWithSecurity)
+	if err != nil {
+		return err
+	}
+
+	data, err := cl.JSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal changelog: %w", err)
+	}
+	data = append(data, '\n')
+
+	if templateOutput == "" {
+		fmt.Print(string(data))
+		return nil
+	}
+	if err := os.WriteFile(templateOutput, data, 0644); err != nil { //nolint:gosec // 0644 intentional for readable output
+		return fmt.Errorf("failed to write %s: %w", templateOutput, err)
+	}
+	fmt.Fprintf(os.Stderr, "Generated %s\n", templateOutput)
+	return nil
+}
+
+// buildTemplateChangelog returns an example changelog whose Unreleased
+// section has one entry per category up to tier, described by the category's
+// subtitle from the change type registry.
+func buildTemplateChangelog(tier changelog.Tier, withSecurity bool) (*changelog.Changelog, error) {
+	cl := changelog.New("example-project")
+	cl.Repository = "https://github.com/example/example-project"
+	cl.Versioning = changelog.VersioningSemVer
+	cl.CommitConvention = changelog.CommitConventionConventional
+	unreleased := cl.EnsureUnreleased()
+
+	for _, ct := range changelog.DefaultRegistry.FilterByMaxTier(tier) {
+		entry := changelog.NewEntry("Example entry " + ct.Subtitle)
+		if ct.Name == changelog.CategorySecurity && withSecurity {
+			entry = changelog.NewEntry("Fix SQL injection in user search endpoint").
+				WithCVE("CVE-2026-12345").
+				WithGHSA("GHSA-abcd-efgh-ijkl").
+				WithSeverity("high").
+				WithCVSS(8.1, "CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:U/C:H/I:H/A:N").
+				WithCWE("CWE-89")
+			entry.AffectedVersions = "1.0.0 - 1.2.2"
+			entry.PatchedVersions = "1.2.3"
+		}
+		if err := unreleased.ApplyEntry(ct.Name, entry); err != nil {
+			return nil, err
+		}
+	}
+	return cl, nil
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+var (
+	versionCheckFile    string
+	versionCheckFromMod bool
+)
+
+var versionCheckCmd = &cobra.Command{
+	Use:   "version-check <file>",
+	Short: "Verify the latest changelog version matches the project version",
+	Long: `Verify that the latest release in a CHANGELOG.json file matches the
+version declared elsewhere in the project. Intended for CI to ensure the
+changelog was bumped when the code was.
+
+Exits with status 0 if the versions match and 1 otherwise.
+
+Version sources (exactly one is required):
+  --version-file   File containing the version string (e.g., VERSION).
+                   A leading "v" is ignored on either side.
+  --from-go-mod    Major version from the module path in ./go.mod
+                   (e.g., "module example.com/foo/v2" requires 2.x.x;
+                   no suffix requires 0.x.x or 1.x.x)
+
+Examples:
+  schangelog version-check --version-file=VERSION CHANGELOG.json
+  schangelog version-check --from-go-mod CHANGELOG.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runVersionCheck,
+}
+
+func init() {
+	versionCheckCmd.Flags().StringVar(&versionCheckFile, "version-file", "", "File containing the expected version")
+	versionCheckCmd.Flags().BoolVar(&versionCheckFromMod, "from-go-mod", false, "Check the major version against the go.mod module path")
+	rootCmd.AddCommand(versionCheckCmd)
+}
+
+func runVersionCheck(cmd *cobra.Command, args []string) error {
+	inputFile := args[0]
+
+	if (versionCheckFile == "") == !versionCheckFromMod {
+		return fmt.Errorf("exactly one of --version-file or --from-go-mod is required")
+	}
+
+	cl, err := changelog.LoadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", inputFile, err)
+	}
+
+	latest := cl.LatestRelease()
+	if latest == nil {
+		fmt.Fprintf(os.Stderr, "  ✗ %s has no releases\n", inputFile)
+		return fmt.Errorf("version check failed")
+	}
+
+	var source, failure string
+	if versionCheckFromMod {
+		source = "go.mod"
+		modulePath, err := readModulePath(source)
+		if err != nil {
+			return err
+		}
+		failure = checkGoModVersion(modulePath, latest.Version)
+	} else {
+		source = versionCheckFile
+		data, err := os.ReadFile(versionCheckFile)
+		if err != nil {
+			return fmt.Errorf("failed to read version file: %w", err)
+		}
+		failure = checkVersionString(strings.TrimSpace(string(data)), latest.Version)
+	}
+
+	if failure != "" {
+		fmt.Fprintf(os.Stderr, "  ✗ %s\n", failure)
+		return fmt.Errorf("version check failed")
+	}
+
+	fmt.Printf("✓ %s latest version %s matches %s\n", inputFile, latest.Version, source)
+	return nil
+}
+
+// checkVersionString compares an expected version with the changelog's latest
+// version, ignoring a leading "v". Returns a failure message, or "" on match.
+func checkVersionString(expected, latest string) string {
+	if strings.TrimPrefix(expected, "v") != strings.TrimPrefix(latest, "v") {
+		return fmt.Sprintf("version file declares %s but latest changelog release is %s", expected, latest)
+	}
+	return ""
+}
+
+// majorSuffixRegex matches a "/vN" major version suffix on a Go module path.
+var majorSuffixRegex = regexp.MustCompile(`/v(\d+)$`)
+
+// checkGoModVersion verifies that the latest version's major number agrees
+// with the module path's major version suffix. Returns a failure message,
+// or "" on match.
+func checkGoModVersion(modulePath, latest string) string {
+	major, _, _ := strings.Cut(strings.TrimPrefix(latest, "v"), ".")
+	if m := majorSuffixRegex.FindStringSubmatch(modulePath); m != nil {
+		if major != m[1] {
+			return fmt.Sprintf("module %s requires major version %s but latest changelog release is %s", modulePath, m[1], latest)
+		}
+		return ""
+	}
+	if major != "0" && major != "1" {
+		return fmt.Sprintf("module %s has no /v%s suffix but latest changelog release is %s", modulePath, major, latest)
+	}
+	return ""
+}
+
+// readModulePath returns the module path declared in a go.mod file.
+func readModulePath(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if rest, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(rest), `"`), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return "", fmt.Errorf("no module directive in %s", path)
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+var (
+	yankFile   string
+	yankReason string
+	yankBackup bool
+)
+
+var yankCmd = &cobra.Command{
+	Use:   "yank <version>",
+	Short: "Mark a release as yanked",
+	Long: `Mark a release in CHANGELOG.json as yanked, for versions that were
+pulled due to a serious bug or security issue. Yanked releases are
+rendered with a [YANKED] marker.
+
+With --reason, the reason is also added to the release's Known Issues.
+The file is updated in place.
+
+Examples:
+  schangelog yank 1.2.0
+  schangelog yank 1.2.0 --reason="Corrupts data when upgrading from 1.1.x"
+  schangelog yank 1.2.0 --file=docs/CHANGELOG.json --backup`,
+	Args: cobra.ExactArgs(1),
+	RunE: runYank,
+}
+
+func init() {
+	yankCmd.Flags().StringVarP(&yankFile, "file", "f", "CHANGELOG.json", "Changelog file to update")
+	yankCmd.Flags().StringVar(&yankReason, "reason", "", "Reason for yanking, added as a Known Issues entry")
+	yankCmd.Flags().BoolVar(&yankBackup, "backup", false, "Copy the existing file to <file>.bak before writing")
+	rootCmd.AddCommand(yankCmd)
+}
+
+func runYank(cmd *cobra.Command, args []string) error {
+	version := args[0]
+
+	cl, err := changelog.LoadFile(yankFile)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", yankFile, err)
+	}
+
+	if err := cl.MarkReleaseYanked(version, yankReason); err != nil {
+		return err
+	}
+
+	write := cl.WriteFile
+	if yankBackup {
+		write = cl.WriteFileWithBackup
+	}
+	if err := write(yankFile); err != nil {
+		return fmt.Errorf("failed to write %s: %w", yankFile, err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Marked %s as yanked in %s\n", version, yankFile)
+	return nil
+}
@@ -51,3 +51,32 @@ func Marshal(v any, f Format) ([]byte, error) {
 func (f Format) String() string {
 	return string(f)
 }
+
+// Unmarshal deserializes data in the specified format into v, which must be
+// a non-nil pointer.
+func Unmarshal(data []byte, f Format, v any) error {
+	switch f {
+	case JSON, JSONCompact:
+		return json.Unmarshal(data, v)
+	default:
+		return UnmarshalTOON(data, v)
+	}
+}
+
+// UnmarshalTOON parses TOON-encoded data into v, which must be a non-nil pointer.
+// The document is decoded into generic values and then mapped onto v by field
+// name, so any value produced by Marshal with TOON (including types such as
+// time.Time that TOON encodes as strings) can be read back.
+func UnmarshalTOON(data []byte, v any) error {
+	decoded, err := toon.Decode(data)
+	if err != nil {
+		return fmt.Errorf("failed to decode TOON: %w", err)
+	}
+
+	intermediate, err := json.Marshal(decoded)
+	if err != nil {
+		return fmt.Errorf("failed to convert TOON: %w", err)
+	}
+
+	return json.Unmarshal(intermediate, v)
+}
@@ -1,8 +1,12 @@
 package format
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
+	"unicode/utf8"
+
+	"github.com/grokify/structured-changelog/gitlog"
 )
 
 func TestParse(t *testing.T) {
@@ -122,3 +126,113 @@ func TestFormatString(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshal(t *testing.T) {
+	type testStruct struct {
+		Name  string `json:"name"`
+		Count int    `json:"count"`
+	}
+
+	want := testStruct{Name: "test", Count: 42}
+
+	for _, f := range []Format{TOON, JSON, JSONCompact} {
+		t.Run(f.String(), func(t *testing.T) {
+			data, err := Marshal(want, f)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			var got testStruct
+			if err := Unmarshal(data, f, &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if got != want {
+				t.Errorf("Unmarshal() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalTOON_ParseResult(t *testing.T) {
+	result := gitlog.NewParseResult()
+	result.Repository = "github.com/example/repo"
+	result.Range.Since = "v1.0.0"
+	result.Range.Until = "HEAD"
+	result.AddCommit(gitlog.Commit{
+		Hash:              "abc123def456",
+		ShortHash:         "abc123d",
+		Author:            "Jane Doe",
+		AuthorEmail:       "jane@example.com",
+		Date:              "2026-01-15",
+		Message:           "feat(api): add endpoint, with comma: and colon",
+		Type:              "feat",
+		Scope:             "api",
+		Subject:           "add endpoint, with comma: and colon",
+		Issue:             12,
+		FilesChanged:      2,
+		Insertions:        30,
+		Deletions:         4,
+		Files:             []string{"api.go", "api_test.go"},
+		SuggestedCategory: "Added",
+		IsExternal:        true,
+		Trailers:          map[string][]string{"Signed-off-by": {"Jane Doe <jane@example.com>", "John Roe <john@example.com>"}, "BREAKING CHANGE": {"drops v1"}},
+	})
+	result.ComputeContributors()
+
+	assertTOONRoundTrip(t, result)
+}
+
+func FuzzUnmarshalTOON_ParseResult(f *testing.F) {
+	f.Add("Jane Doe", "feat: add thing", "body text", "main.go", 10)
+	f.Add("", "fix(core): handle \"quotes\", commas: and colons", "multi\nline\nbody", "dir/file with spaces.go", 0)
+	f.Add("- dash", "123", "true", "null", -1)
+
+	f.Fuzz(func(t *testing.T, author, subject, body, file string, insertions int) {
+		for _, s := range []string{author, subject, body, file} {
+			if !utf8.ValidString(s) {
+				t.Skip("invalid UTF-8 does not round-trip through JSON")
+			}
+		}
+
+		result := gitlog.NewParseResult()
+		result.AddCommit(gitlog.Commit{
+			Hash:       "abc123",
+			Author:     author,
+			Subject:    subject,
+			Message:    subject,
+			Body:       body,
+			Files:      []string{file},
+			Insertions: insertions,
+		})
+		result.ComputeContributors()
+
+		// The TOON encoder rejects some inputs (e.g., control characters);
+		// only values it can encode are expected to round-trip.
+		if _, err := Marshal(result, TOON); err != nil {
+			t.Skip(err)
+		}
+
+		assertTOONRoundTrip(t, result)
+	})
+}
+
+// assertTOONRoundTrip marshals v to TOON, unmarshals it back, and compares
+// the JSON encodings of the original and decoded values.
+func assertTOONRoundTrip(t *testing.T, v *gitlog.ParseResult) {
+	t.Helper()
+
+	data, err := Marshal(v, TOON)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var got gitlog.ParseResult
+	if err := UnmarshalTOON(data, &got); err != nil {
+		t.Fatalf("UnmarshalTOON() error = %v\nTOON:\n%s", err, data)
+	}
+
+	want, _ := json.Marshal(v)
+	gotJSON, _ := json.Marshal(&got)
+	if string(gotJSON) != string(want) {
+		t.Errorf("round trip mismatch\nwant: %s\ngot:  %s\nTOON:\n%s", want, gotJSON, data)
+	}
+}
@@ -0,0 +1,58 @@
+package gitlog
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+)
+
+// ErrEmptyRef is returned when a required git ref is empty.
+var ErrEmptyRef = errors.New("git ref is required")
+
+// GetBranchCommits returns the commits reachable from branch but not from base,
+// equivalent to "git log base..branch". This is useful for PR-based workflows
+// to preview what a feature branch adds before it is merged.
+func GetBranchCommits(branch, base string) (*ParseResult, error) {
+	return NewParser().GetBranchCommits(branch, base)
+}
+
+// GetBranchCommits is like the package-level GetBranchCommits but parses the
+// output with p, applying its settings such as category overrides.
+func (p *Parser) GetBranchCommits(branch, base string) (*ParseResult, error) {
+	if branch == "" {
+		return nil, fmt.Errorf("%w: branch", ErrEmptyRef)
+	}
+	if base == "" {
+		return nil, fmt.Errorf("%w: base", ErrEmptyRef)
+	}
+
+	cmd := exec.Command("git", branchLogArgs(branch, base)...)
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("git log failed: %s", string(exitErr.Stderr))
+		}
+		return nil, fmt.Errorf("failed to run git log: %w", err)
+	}
+
+	result, err := p.Parse(string(output))
+	if err != nil {
+		return nil, err
+	}
+
+	result.Range.Since = base
+	result.Range.Until = branch
+	return result, nil
+}
+
+// branchLogArgs returns the git log arguments for listing commits on branch
+// that are not on base.
+func branchLogArgs(branch, base string) []string {
+	return []string{
+		"log",
+		"--format=" + GitLogFormat,
+		"--numstat",
+		fmt.Sprintf("%s..%s", base, branch),
+	}
+}
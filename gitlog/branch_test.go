@@ -0,0 +1,43 @@
+package gitlog
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGetBranchCommits_EmptyRefs(t *testing.T) {
+	tests := []struct {
+		name   string
+		branch string
+		base   string
+	}{
+		{"empty_branch", "", "main"},
+		{"empty_base", "feature/x", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := GetBranchCommits(tt.branch, tt.base)
+			if !errors.Is(err, ErrEmptyRef) {
+				t.Errorf("GetBranchCommits(%q, %q) error = %v, expected ErrEmptyRef", tt.branch, tt.base, err)
+			}
+		})
+	}
+}
+
+func TestBranchLogArgs(t *testing.T) {
+	args := branchLogArgs("feature/x", "main")
+
+	if len(args) != 4 {
+		t.Fatalf("expected 4 args, got %d: %v", len(args), args)
+	}
+	if args[0] != "log" {
+		t.Errorf("expected first arg 'log', got %q", args[0])
+	}
+	if args[1] != "--format="+GitLogFormat {
+		t.Errorf("unexpected format arg %q", args[1])
+	}
+	if args[3] != "main..feature/x" {
+		t.Errorf("expected range 'main..feature/x', got %q", args[3])
+	}
+}
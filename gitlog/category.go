@@ -96,7 +96,16 @@ var categoryMapping = map[string]CategorySuggestion{
 
 // SuggestCategory suggests a changelog category for a commit based on its type.
 func SuggestCategory(commitType string) *CategorySuggestion {
+	return suggestCategory(commitType, nil)
+}
+
+// suggestCategory is like SuggestCategory but consults overrides, keyed by
+// lowercase commit type, before the built-in mapping.
+func suggestCategory(commitType string, overrides map[string]CategorySuggestion) *CategorySuggestion {
 	t := strings.ToLower(commitType)
+	if suggestion, ok := overrides[t]; ok {
+		return &suggestion
+	}
 	if suggestion, ok := categoryMapping[t]; ok {
 		return &suggestion
 	}
@@ -104,135 +113,182 @@ func SuggestCategory(commitType string) *CategorySuggestion {
 }
 
 // SuggestCategoryFromMessage suggests a category by parsing the commit message.
-func SuggestCategoryFromMessage(message string) *CategorySuggestion {
+// It returns the primary suggestion and alternative categories for commits that
+// touch multiple concerns. For example, "feat(api)!: rename endpoint and fix
+// memory leak" suggests Breaking with Added and Fixed as alternatives.
+// Alternatives never repeat the primary category.
+func SuggestCategoryFromMessage(message string) (*CategorySuggestion, []CategorySuggestion) {
+	return suggestCategoryFromMessage(message, nil)
+}
+
+// suggestCategoryFromMessage is like SuggestCategoryFromMessage but applies
+// commit type overrides; see Parser.SetCategoryOverrides.
+func suggestCategoryFromMessage(message string, overrides map[string]CategorySuggestion) (*CategorySuggestion, []CategorySuggestion) {
 	cc := ParseConventionalCommit(message)
 	if cc == nil {
-		return inferCategoryFromMessage(message)
+		matches := matchInferencePatterns(message)
+		if len(matches) == 0 {
+			// Default to Changed with low confidence
+			return &CategorySuggestion{
+				Category:   "Changed",
+				Tier:       "core",
+				Confidence: 0.30,
+				Reasoning:  "Unable to determine specific category from message",
+			}, nil
+		}
+		return &matches[0], matches[1:]
 	}
 
+	var primary *CategorySuggestion
+	var candidates []CategorySuggestion
+
+	typeSuggestion := suggestCategory(cc.Type, overrides)
+
 	// Check for breaking change markers first
-	if cc.Breaking {
-		return &CategorySuggestion{
+	lines := strings.SplitN(message, "\n", 2)
+	switch {
+	case cc.Breaking:
+		primary = &CategorySuggestion{
 			Category:   "Breaking",
 			Tier:       "standard",
 			Confidence: 0.95,
 			Reasoning:  "Commit marked with '!' indicates breaking change",
 		}
-	}
-
-	// Check if body has BREAKING CHANGE
-	lines := strings.SplitN(message, "\n", 2)
-	if len(lines) > 1 && HasBreakingChangeMarker(lines[1]) {
-		return &CategorySuggestion{
+	case len(lines) > 1 && HasBreakingChangeMarker(lines[1]):
+		primary = &CategorySuggestion{
 			Category:   "Breaking",
 			Tier:       "standard",
 			Confidence: 0.95,
 			Reasoning:  "Commit body contains BREAKING CHANGE marker",
 		}
+	default:
+		primary = typeSuggestion
 	}
 
-	return SuggestCategory(cc.Type)
-}
+	// A breaking commit's type still describes what kind of change it is
+	if primary != typeSuggestion && typeSuggestion != nil {
+		candidates = append(candidates, *typeSuggestion)
+	}
+	candidates = append(candidates, matchInferencePatterns(cc.Subject)...)
 
-// inferCategoryFromMessage attempts to infer category from non-conventional commits.
-func inferCategoryFromMessage(message string) *CategorySuggestion {
-	lower := strings.ToLower(message)
+	var primaryCategory string
+	if primary != nil {
+		primaryCategory = primary.Category
+	}
+	return primary, dedupeSuggestions(candidates, primaryCategory)
+}
 
-	// Check for common patterns
-	// Note: Order matters - more specific patterns (like security) should come before generic ones (like fix)
-	patterns := []struct {
-		keywords   []string
-		suggestion CategorySuggestion
-	}{
-		{
-			keywords: []string{"security", "cve", "vulnerability", "exploit"},
-			suggestion: CategorySuggestion{
-				Category:   "Security",
-				Tier:       "core",
-				Confidence: 0.70,
-				Reasoning:  "Message contains security-related keywords",
-			},
+// inferencePatterns maps message keywords to categories for non-conventional
+// commits and for detecting additional concerns in conventional commit subjects.
+// Note: Order matters - more specific patterns (like security) should come before generic ones (like fix)
+var inferencePatterns = []struct {
+	keywords   []string
+	suggestion CategorySuggestion
+}{
+	{
+		keywords: []string{"security", "cve", "vulnerability", "exploit"},
+		suggestion: CategorySuggestion{
+			Category:   "Security",
+			Tier:       "core",
+			Confidence: 0.70,
+			Reasoning:  "Message contains security-related keywords",
 		},
-		{
-			keywords: []string{"add ", "adds ", "added ", "adding ", "new ", "introduce ", "implement "},
-			suggestion: CategorySuggestion{
-				Category:   "Added",
-				Tier:       "core",
-				Confidence: 0.60,
-				Reasoning:  "Message suggests new functionality",
-			},
+	},
+	{
+		keywords: []string{"add ", "adds ", "added ", "adding ", "new ", "introduce ", "implement "},
+		suggestion: CategorySuggestion{
+			Category:   "Added",
+			Tier:       "core",
+			Confidence: 0.60,
+			Reasoning:  "Message suggests new functionality",
 		},
-		{
-			keywords: []string{"fix ", "fixes ", "fixed ", "fixing ", "bug ", "resolve ", "repair "},
-			suggestion: CategorySuggestion{
-				Category:   "Fixed",
-				Tier:       "core",
-				Confidence: 0.60,
-				Reasoning:  "Message suggests bug fix",
-			},
+	},
+	{
+		keywords: []string{"fix ", "fixes ", "fixed ", "fixing ", "bug ", "resolve ", "repair "},
+		suggestion: CategorySuggestion{
+			Category:   "Fixed",
+			Tier:       "core",
+			Confidence: 0.60,
+			Reasoning:  "Message suggests bug fix",
 		},
-		{
-			keywords: []string{"remove ", "removes ", "removed ", "delete ", "drop "},
-			suggestion: CategorySuggestion{
-				Category:   "Removed",
-				Tier:       "core",
-				Confidence: 0.60,
-				Reasoning:  "Message suggests removal",
-			},
+	},
+	{
+		keywords: []string{"remove ", "removes ", "removed ", "delete ", "drop "},
+		suggestion: CategorySuggestion{
+			Category:   "Removed",
+			Tier:       "core",
+			Confidence: 0.60,
+			Reasoning:  "Message suggests removal",
 		},
-		{
-			keywords: []string{"deprecate ", "deprecates ", "deprecated "},
-			suggestion: CategorySuggestion{
-				Category:   "Deprecated",
-				Tier:       "core",
-				Confidence: 0.70,
-				Reasoning:  "Message indicates deprecation",
-			},
+	},
+	{
+		keywords: []string{"deprecate ", "deprecates ", "deprecated "},
+		suggestion: CategorySuggestion{
+			Category:   "Deprecated",
+			Tier:       "core",
+			Confidence: 0.70,
+			Reasoning:  "Message indicates deprecation",
 		},
-		{
-			keywords: []string{"update readme", "update doc", "documentation"},
-			suggestion: CategorySuggestion{
-				Category:   "Documentation",
-				Tier:       "extended",
-				Confidence: 0.60,
-				Reasoning:  "Message suggests documentation changes",
-			},
+	},
+	{
+		keywords: []string{"update readme", "update doc", "documentation"},
+		suggestion: CategorySuggestion{
+			Category:   "Documentation",
+			Tier:       "extended",
+			Confidence: 0.60,
+			Reasoning:  "Message suggests documentation changes",
 		},
-		{
-			keywords: []string{"upgrade ", "bump ", "update depend", "update go.mod"},
-			suggestion: CategorySuggestion{
-				Category:   "Dependencies",
-				Tier:       "standard",
-				Confidence: 0.65,
-				Reasoning:  "Message suggests dependency updates",
-			},
+	},
+	{
+		keywords: []string{"upgrade ", "bump ", "update depend", "update go.mod"},
+		suggestion: CategorySuggestion{
+			Category:   "Dependencies",
+			Tier:       "standard",
+			Confidence: 0.65,
+			Reasoning:  "Message suggests dependency updates",
 		},
-		{
-			keywords: []string{"performance", "optimize", "speed up", "faster"},
-			suggestion: CategorySuggestion{
-				Category:   "Performance",
-				Tier:       "standard",
-				Confidence: 0.60,
-				Reasoning:  "Message suggests performance improvement",
-			},
+	},
+	{
+		keywords: []string{"performance", "optimize", "speed up", "faster"},
+		suggestion: CategorySuggestion{
+			Category:   "Performance",
+			Tier:       "standard",
+			Confidence: 0.60,
+			Reasoning:  "Message suggests performance improvement",
 		},
-	}
+	},
+}
+
+// matchInferencePatterns returns a suggestion for every keyword pattern that
+// matches the message, in pattern order.
+func matchInferencePatterns(message string) []CategorySuggestion {
+	lower := strings.ToLower(message)
 
-	for _, p := range patterns {
+	var matches []CategorySuggestion
+	for _, p := range inferencePatterns {
 		for _, kw := range p.keywords {
 			if strings.Contains(lower, kw) {
-				return &p.suggestion
+				matches = append(matches, p.suggestion)
+				break
 			}
 		}
 	}
+	return matches
+}
 
-	// Default to Changed with low confidence
-	return &CategorySuggestion{
-		Category:   "Changed",
-		Tier:       "core",
-		Confidence: 0.30,
-		Reasoning:  "Unable to determine specific category from message",
+// dedupeSuggestions removes suggestions for the excluded category and
+// repeated categories, keeping the first occurrence.
+func dedupeSuggestions(suggestions []CategorySuggestion, exclude string) []CategorySuggestion {
+	seen := map[string]bool{exclude: true}
+	var out []CategorySuggestion
+	for _, s := range suggestions {
+		if seen[s.Category] {
+			continue
+		}
+		seen[s.Category] = true
+		out = append(out, s)
 	}
+	return out
 }
 
 // GetCategoryMapping returns the full category mapping for reference.
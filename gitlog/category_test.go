@@ -137,7 +137,7 @@ func TestSuggestCategoryFromMessage(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := SuggestCategoryFromMessage(tt.message)
+			result, _ := SuggestCategoryFromMessage(tt.message)
 			if result == nil {
 				t.Errorf("expected suggestion, got nil")
 				return
@@ -152,6 +152,58 @@ func TestSuggestCategoryFromMessage(t *testing.T) {
 	}
 }
 
+func TestSuggestCategoryFromMessage_Alternatives(t *testing.T) {
+	tests := []struct {
+		name                 string
+		message              string
+		expectedPrimary      string
+		expectedAlternatives []string
+	}{
+		{
+			name:                 "breaking feat with fix",
+			message:              "feat(api)!: rename endpoint and fix memory leak",
+			expectedPrimary:      "Breaking",
+			expectedAlternatives: []string{"Added", "Fixed"},
+		},
+		{
+			name:                 "feat with fix",
+			message:              "feat: support retries and fix timeout handling",
+			expectedPrimary:      "Added",
+			expectedAlternatives: []string{"Fixed"},
+		},
+		{
+			name:                 "single concern",
+			message:              "fix: resolve bug",
+			expectedPrimary:      "Fixed",
+			expectedAlternatives: nil,
+		},
+		{
+			name:                 "non-conventional multiple concerns",
+			message:              "Add retries and remove legacy client",
+			expectedPrimary:      "Added",
+			expectedAlternatives: []string{"Removed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary, alternatives := SuggestCategoryFromMessage(tt.message)
+			if primary == nil || primary.Category != tt.expectedPrimary {
+				t.Fatalf("expected primary %s, got %+v", tt.expectedPrimary, primary)
+			}
+			got := suggestionCategories(alternatives)
+			if len(got) != len(tt.expectedAlternatives) {
+				t.Fatalf("expected alternatives %v, got %v", tt.expectedAlternatives, got)
+			}
+			for i := range got {
+				if got[i] != tt.expectedAlternatives[i] {
+					t.Errorf("expected alternatives %v, got %v", tt.expectedAlternatives, got)
+				}
+			}
+		})
+	}
+}
+
 func TestSuggestCategoryTiers(t *testing.T) {
 	tests := []struct {
 		commitType   string
@@ -0,0 +1,109 @@
+package gitlog
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+// ErrNilChangelog is returned by ToChangelogMerge when existing is nil.
+var ErrNilChangelog = errors.New("changelog is nil")
+
+// ToChangelog returns a new changelog for project with the commits as
+// entries in the Unreleased section. See ToChangelogMerge.
+func (pr *ParseResult) ToChangelog(project string) (*changelog.Changelog, error) {
+	cl := changelog.New(project)
+	if err := pr.ToChangelogMerge(cl); err != nil {
+		return nil, err
+	}
+	return cl, nil
+}
+
+// ToChangelogMerge appends the commits as entries to the Unreleased section
+// of existing, creating it if needed. Commits whose hash matches the Commit
+// of an entry already in the Unreleased section are skipped, so the same
+// commits can be merged repeatedly. Each commit is added to its
+// SuggestedCategory, or to Added, Fixed, or Changed by commit type.
+func (pr *ParseResult) ToChangelogMerge(existing *changelog.Changelog) error {
+	if existing == nil {
+		return ErrNilChangelog
+	}
+	unreleased := existing.EnsureUnreleased()
+
+	var seen []string
+	for _, cat := range unreleased.Categories() {
+		for _, e := range cat.Entries {
+			if e.Commit != "" {
+				seen = append(seen, e.Commit)
+			}
+		}
+	}
+
+	for _, c := range pr.Commits {
+		entry := c.ToEntry()
+		if entry.Commit != "" && containsCommitHash(seen, entry.Commit) {
+			continue
+		}
+		if err := unreleased.ApplyEntry(c.changelogCategory(), entry); err != nil {
+			return fmt.Errorf("commit %s: %w", entry.Commit, err)
+		}
+		if entry.Commit != "" {
+			seen = append(seen, entry.Commit)
+		}
+	}
+	return nil
+}
+
+// ToEntry returns a changelog entry for the commit, using the subject as the
+// description and the short hash (or full hash) as the commit reference.
+func (c Commit) ToEntry() changelog.Entry {
+	entry := changelog.Entry{
+		Description: c.Subject,
+		Commit:      c.ShortHash,
+		Breaking:    c.Breaking,
+	}
+	if entry.Description == "" {
+		entry.Description = c.Message
+	}
+	if entry.Commit == "" {
+		entry.Commit = c.Hash
+	}
+	if c.Issue > 0 {
+		entry.Issue = strconv.Itoa(c.Issue)
+	}
+	if c.PR > 0 {
+		entry.PR = strconv.Itoa(c.PR)
+	}
+	return entry
+}
+
+// changelogCategory returns the changelog category for the commit: its
+// SuggestedCategory if set, otherwise Added for feat, Fixed for fix, and
+// Changed for anything else.
+func (c Commit) changelogCategory() string {
+	if c.SuggestedCategory != "" {
+		return c.SuggestedCategory
+	}
+	switch c.Type {
+	case "feat":
+		return changelog.CategoryAdded
+	case "fix":
+		return changelog.CategoryFixed
+	default:
+		return changelog.CategoryChanged
+	}
+}
+
+// containsCommitHash returns true if any hash in hashes refers to the same
+// commit as hash, allowing either to be abbreviated.
+func containsCommitHash(hashes []string, hash string) bool {
+	for _, h := range hashes {
+		if strings.HasPrefix(h, hash) || strings.HasPrefix(hash, h) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package gitlog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+func TestParseResult_ToChangelogMerge(t *testing.T) {
+	cl := changelog.New("test")
+	cl.EnsureUnreleased().AddFixed(changelog.Entry{Description: "Existing fix", Commit: "a2"})
+
+	pr := NewParseResult()
+	pr.AddCommit(Commit{Hash: "a1ffff", ShortHash: "a1", Type: "feat", Subject: "add export", SuggestedCategory: "Added", PR: 12})
+	pr.AddCommit(Commit{Hash: "a2ffff", ShortHash: "a2", Type: "fix", Subject: "fix crash"})
+	pr.AddCommit(Commit{Hash: "a3ffff", ShortHash: "a3", Type: "chore", Message: "chore: tidy"})
+
+	if err := pr.ToChangelogMerge(cl); err != nil {
+		t.Fatalf("ToChangelogMerge failed: %v", err)
+	}
+
+	u := cl.Unreleased
+	if len(u.Added) != 1 || u.Added[0].Description != "add export" || u.Added[0].PR != "12" || u.Added[0].Commit != "a1" {
+		t.Errorf("unexpected Added: %+v", u.Added)
+	}
+	if len(u.Fixed) != 1 || u.Fixed[0].Description != "Existing fix" {
+		t.Errorf("expected duplicate commit to be skipped, got %+v", u.Fixed)
+	}
+	if len(u.Changed) != 1 || u.Changed[0].Description != "chore: tidy" {
+		t.Errorf("unexpected Changed: %+v", u.Changed)
+	}
+
+	// Merging again, with full hashes, adds nothing
+	pr.AddCommit(Commit{Hash: "a1ffff", Type: "feat", Subject: "add export"})
+	if err := pr.ToChangelogMerge(cl); err != nil {
+		t.Fatalf("ToChangelogMerge failed: %v", err)
+	}
+	if len(u.Added) != 1 || len(u.Fixed) != 1 || len(u.Changed) != 1 {
+		t.Errorf("expected repeated merge to add nothing, got %+v", u)
+	}
+
+	if err := pr.ToChangelogMerge(nil); !errors.Is(err, ErrNilChangelog) {
+		t.Errorf("expected ErrNilChangelog, got %v", err)
+	}
+}
+
+func TestParseResult_ToChangelog(t *testing.T) {
+	pr := NewParseResult()
+	pr.AddCommit(Commit{ShortHash: "b1", Type: "fix", Subject: "fix leak", Issue: 7, Breaking: true})
+
+	cl, err := pr.ToChangelog("test")
+	if err != nil {
+		t.Fatalf("ToChangelog failed: %v", err)
+	}
+	if cl.Project != "test" || cl.Unreleased == nil || len(cl.Unreleased.Fixed) != 1 {
+		t.Fatalf("unexpected changelog: %+v", cl)
+	}
+	if e := cl.Unreleased.Fixed[0]; e.Issue != "7" || !e.Breaking {
+		t.Errorf("unexpected entry: %+v", e)
+	}
+}
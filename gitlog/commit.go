@@ -3,30 +3,40 @@
 package gitlog
 
 import (
+	"net/url"
+	"strings"
 	"time"
 )
 
 // Commit represents a parsed git commit with structured metadata.
 type Commit struct {
-	Hash              string   `json:"hash"`
-	ShortHash         string   `json:"shortHash"`
-	Author            string   `json:"author"`
-	AuthorEmail       string   `json:"authorEmail,omitempty"`
-	Date              string   `json:"date"`
-	Message           string   `json:"message"`
-	Body              string   `json:"body,omitempty"`
-	Type              string   `json:"type,omitempty"`
-	Scope             string   `json:"scope,omitempty"`
-	Subject           string   `json:"subject"`
-	Breaking          bool     `json:"breaking,omitempty"`
-	Issue             int      `json:"issue,omitempty"`
-	PR                int      `json:"pr,omitempty"`
-	FilesChanged      int      `json:"filesChanged,omitempty"`
-	Insertions        int      `json:"insertions,omitempty"`
-	Deletions         int      `json:"deletions,omitempty"`
-	Files             []string `json:"files,omitempty"`
-	SuggestedCategory string   `json:"suggestedCategory,omitempty"`
-	IsExternal        bool     `json:"isExternal,omitempty"`
+	Hash                  string   `json:"hash"`
+	ShortHash             string   `json:"shortHash"`
+	Author                string   `json:"author"`
+	AuthorEmail           string   `json:"authorEmail,omitempty"`
+	Date                  string   `json:"date"`
+	Message               string   `json:"message"`
+	Body                  string   `json:"body,omitempty"`
+	Type                  string   `json:"type,omitempty"`
+	Scope                 string   `json:"scope,omitempty"`
+	Subject               string   `json:"subject"`
+	Breaking              bool     `json:"breaking,omitempty"`
+	Issue                 int      `json:"issue,omitempty"`
+	PR                    int      `json:"pr,omitempty"`
+	FilesChanged          int      `json:"filesChanged,omitempty"`
+	Insertions            int      `json:"insertions,omitempty"`
+	Deletions             int      `json:"deletions,omitempty"`
+	Files                 []string `json:"files,omitempty"`
+	SuggestedCategory     string   `json:"suggestedCategory,omitempty"`
+	AlternativeCategories []string `json:"alternativeCategories,omitempty"`
+	IsExternal            bool     `json:"isExternal,omitempty"`
+
+	// SignedOff is true if the body has a Signed-off-by trailer (DCO sign-off).
+	SignedOff bool `json:"signedOff,omitempty"`
+
+	// Trailers holds the footer trailers requested with Parser.SetTrailerKeys,
+	// with one value per occurrence; see ParseTrailerValues.
+	Trailers map[string][]string `json:"trailers,omitempty"`
 }
 
 // Range represents the commit range that was parsed.
@@ -43,6 +53,11 @@ type Summary struct {
 	TotalFilesChanged   int            `json:"totalFilesChanged,omitempty"`
 	TotalInsertions     int            `json:"totalInsertions,omitempty"`
 	TotalDeletions      int            `json:"totalDeletions,omitempty"`
+
+	// TotalLinesChanged and NetLinesChanged are TotalInsertions plus and
+	// minus TotalDeletions; see ParseResult.TotalLinesChanged.
+	TotalLinesChanged int `json:"totalLinesChanged,omitempty"`
+	NetLinesChanged   int `json:"netLinesChanged,omitempty"`
 }
 
 // Contributor represents an author with commit count.
@@ -60,6 +75,9 @@ type ParseResult struct {
 	Commits      []Commit      `json:"commits"`
 	Summary      Summary       `json:"summary"`
 	Contributors []Contributor `json:"contributors,omitempty"`
+
+	// SuggestedReleaseType is set by callers from SuggestReleaseType.
+	SuggestedReleaseType string `json:"suggestedReleaseType,omitempty"`
 }
 
 // NewParseResult creates a new ParseResult with initialized maps.
@@ -93,6 +111,19 @@ func (pr *ParseResult) AddCommit(c Commit) {
 	pr.Summary.TotalFilesChanged += c.FilesChanged
 	pr.Summary.TotalInsertions += c.Insertions
 	pr.Summary.TotalDeletions += c.Deletions
+	pr.Summary.TotalLinesChanged = pr.TotalLinesChanged()
+	pr.Summary.NetLinesChanged = pr.NetLinesChanged()
+}
+
+// TotalLinesChanged returns the total lines inserted and deleted.
+func (pr *ParseResult) TotalLinesChanged() int {
+	return pr.Summary.TotalInsertions + pr.Summary.TotalDeletions
+}
+
+// NetLinesChanged returns lines inserted minus lines deleted, which is
+// negative if more lines were deleted.
+func (pr *ParseResult) NetLinesChanged() int {
+	return pr.Summary.TotalInsertions - pr.Summary.TotalDeletions
 }
 
 // ComputeContributors builds the Contributors list from commits.
@@ -144,3 +175,64 @@ func (pr *ParseResult) ComputeContributors() {
 	// External contributors first
 	pr.Contributors = append(external, internal...)
 }
+
+// ExternalContributors returns the contributors marked as external, sorted
+// by commit count descending. Contributors are computed if needed.
+func (pr *ParseResult) ExternalContributors() []Contributor {
+	return pr.contributorsWhere(true)
+}
+
+// InternalContributors returns the contributors not marked as external,
+// sorted by commit count descending. Contributors are computed if needed.
+func (pr *ParseResult) InternalContributors() []Contributor {
+	return pr.contributorsWhere(false)
+}
+
+// contributorsWhere returns the contributors whose IsExternal equals external.
+// ComputeContributors already sorts each group by commit count.
+func (pr *ParseResult) contributorsWhere(external bool) []Contributor {
+	if pr.Contributors == nil {
+		pr.ComputeContributors()
+	}
+	var result []Contributor
+	for _, c := range pr.Contributors {
+		if c.IsExternal == external {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// UnsignedOffCommits returns the external commits without a Signed-off-by
+// trailer. Commits are only external if IsExternal has been set.
+func (pr *ParseResult) UnsignedOffCommits() []Commit {
+	var result []Commit
+	for _, c := range pr.Commits {
+		if c.IsExternal && !c.SignedOff {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// Permalink returns baseURL with the range as query parameters, e.g.
+// "https://example.com/commits?since=v1.0.0&until=v1.1.0". Empty bounds are
+// omitted, and parameters are appended to any query baseURL already has.
+func (pr *ParseResult) Permalink(baseURL string) string {
+	q := url.Values{}
+	if pr.Range.Since != "" {
+		q.Set("since", pr.Range.Since)
+	}
+	if pr.Range.Until != "" {
+		q.Set("until", pr.Range.Until)
+	}
+	if len(q) == 0 {
+		return baseURL
+	}
+
+	sep := "?"
+	if strings.Contains(baseURL, "?") {
+		sep = "&"
+	}
+	return baseURL + sep + q.Encode()
+}
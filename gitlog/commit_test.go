@@ -0,0 +1,81 @@
+package gitlog
+
+import "testing"
+
+func TestParseResult_ExternalAndInternalContributors(t *testing.T) {
+	pr := NewParseResult()
+	pr.AddCommit(Commit{Author: "Alice"})
+	pr.AddCommit(Commit{Author: "Bob", IsExternal: true})
+	pr.AddCommit(Commit{Author: "Carol", IsExternal: true})
+	pr.AddCommit(Commit{Author: "Carol", IsExternal: true})
+	pr.AddCommit(Commit{Author: "Dave"})
+	pr.AddCommit(Commit{Author: "Dave"})
+
+	// Computed lazily without calling ComputeContributors
+	external := pr.ExternalContributors()
+	if len(external) != 2 || external[0].Name != "Carol" || external[0].CommitCount != 2 || external[1].Name != "Bob" {
+		t.Errorf("unexpected external contributors: %+v", external)
+	}
+	if pr.Contributors == nil {
+		t.Error("expected contributors to be computed")
+	}
+
+	internal := pr.InternalContributors()
+	if len(internal) != 2 || internal[0].Name != "Dave" || internal[1].Name != "Alice" {
+		t.Errorf("unexpected internal contributors: %+v", internal)
+	}
+}
+
+func TestParseResult_UnsignedOffCommits(t *testing.T) {
+	pr := NewParseResult()
+	pr.AddCommit(Commit{ShortHash: "a1", Author: "Alice"})
+	pr.AddCommit(Commit{ShortHash: "a2", Author: "Bob", IsExternal: true, SignedOff: true})
+	pr.AddCommit(Commit{ShortHash: "a3", Author: "Carol", IsExternal: true})
+
+	got := pr.UnsignedOffCommits()
+	if len(got) != 1 || got[0].ShortHash != "a3" {
+		t.Errorf("expected only a3, got %+v", got)
+	}
+}
+
+func TestParseResult_LinesChanged(t *testing.T) {
+	pr := NewParseResult()
+	pr.AddCommit(Commit{Insertions: 10, Deletions: 4})
+	pr.AddCommit(Commit{Insertions: 1, Deletions: 20})
+
+	if got := pr.TotalLinesChanged(); got != 35 {
+		t.Errorf("TotalLinesChanged() = %d, want 35", got)
+	}
+	if got := pr.NetLinesChanged(); got != -13 {
+		t.Errorf("NetLinesChanged() = %d, want -13", got)
+	}
+	if pr.Summary.TotalLinesChanged != 35 || pr.Summary.NetLinesChanged != -13 {
+		t.Errorf("expected summary fields to match, got %+v", pr.Summary)
+	}
+}
+
+func TestParseResult_Permalink(t *testing.T) {
+	tests := []struct {
+		name         string
+		since, until string
+		baseURL      string
+		want         string
+	}{
+		{"range", "v1.0.0", "v1.1.0", "https://example.com/commits", "https://example.com/commits?since=v1.0.0&until=v1.1.0"},
+		{"special characters", "sdk/go/v1.0.0", "release candidate+1&2", "https://example.com/commits",
+			"https://example.com/commits?since=sdk%2Fgo%2Fv1.0.0&until=release+candidate%2B1%262"},
+		{"existing query", "v1.0.0", "", "https://example.com/commits?repo=x", "https://example.com/commits?repo=x&since=v1.0.0"},
+		{"empty range", "", "", "https://example.com/commits", "https://example.com/commits"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := NewParseResult()
+			pr.Range.Since = tt.since
+			pr.Range.Until = tt.until
+			if got := pr.Permalink(tt.baseURL); got != tt.want {
+				t.Errorf("Permalink() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,9 +1,21 @@
 package gitlog
 
 import (
+	"errors"
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Conventional commit conformance errors returned by ConventionalCommit.Validate.
+var (
+	ErrTypeNotLowercase      = errors.New("type must be lowercase")
+	ErrEmptyScope            = errors.New("scope must not be empty")
+	ErrSubjectNotLowercase   = errors.New("subject must start with a lowercase letter")
+	ErrSubjectTrailingPeriod = errors.New("subject must not end with a period")
 )
 
 // ConventionalCommit represents parsed components of a conventional commit message.
@@ -12,6 +24,58 @@ type ConventionalCommit struct {
 	Scope    string `json:"scope,omitempty"`
 	Subject  string `json:"subject"`
 	Breaking bool   `json:"breaking"`
+
+	// FooterTrailers maps footer tokens (e.g., "Reviewed-by", "Refs",
+	// "BREAKING CHANGE") to their values, parsed from the message body.
+	FooterTrailers map[string]string `json:"footerTrailers,omitempty"`
+
+	// rawType is the type as written, before lowercasing, for Validate.
+	rawType string
+}
+
+// Validate checks the commit against the conventional commit spec strictly:
+// the type must be lowercase, a scope if present must not be blank, the
+// subject must start with a lowercase letter, and the subject must not end
+// with a period. A subject whose first word has further uppercase letters
+// (e.g., "GitHub", "API") is treated as starting with a proper noun.
+//
+// All violations are returned joined with errors.Join; use errors.Is to test
+// for a specific one, or Unwrap() []error to list them. Returns nil if the
+// commit conforms.
+func (cc *ConventionalCommit) Validate() error {
+	var errs []error
+	typ := cc.Type
+	if cc.rawType != "" {
+		typ = cc.rawType
+	}
+	if typ != strings.ToLower(typ) {
+		errs = append(errs, fmt.Errorf("%w: %q", ErrTypeNotLowercase, typ))
+	}
+	if cc.Scope != "" && strings.TrimSpace(cc.Scope) == "" {
+		errs = append(errs, ErrEmptyScope)
+	}
+	if r, _ := utf8.DecodeRuneInString(cc.Subject); unicode.IsUpper(r) && !startsWithProperNoun(cc.Subject) {
+		errs = append(errs, fmt.Errorf("%w: %q", ErrSubjectNotLowercase, cc.Subject))
+	}
+	if strings.HasSuffix(cc.Subject, ".") {
+		errs = append(errs, ErrSubjectTrailingPeriod)
+	}
+	return errors.Join(errs...)
+}
+
+// startsWithProperNoun reports whether the first word of s has an uppercase
+// letter after its first character, as in "GitHub", "OAuth", or "API".
+func startsWithProperNoun(s string) bool {
+	word, _, _ := strings.Cut(s, " ")
+	_, size := utf8.DecodeRuneInString(word)
+	return strings.IndexFunc(word[size:], unicode.IsUpper) >= 0
+}
+
+// GetTrailer returns the value of the footer trailer with the given token,
+// matched case-insensitively, or "" if it is not present.
+func (cc *ConventionalCommit) GetTrailer(key string) string {
+	v, _ := lookupTrailer(cc.FooterTrailers, key)
+	return v
 }
 
 // conventionalCommitRegex matches the conventional commit format:
@@ -32,6 +96,10 @@ var prRefRegex = regexp.MustCompile(`\(#(\d+)\)\s*$`)
 // breakingChangeRegex matches BREAKING CHANGE: in body
 var breakingChangeRegex = regexp.MustCompile(`(?i)^BREAKING[ -]CHANGE\s*:`)
 
+// trailerRegex matches a footer line: "Token: value" or "Token #value".
+// Tokens use "-" in place of spaces, except for BREAKING CHANGE.
+var trailerRegex = regexp.MustCompile(`^((?i:BREAKING CHANGE)|[A-Za-z][A-Za-z0-9-]*)(\s*:| #)(.*)$`)
+
 // ParseConventionalCommit parses a commit message into conventional commit components.
 // Returns nil if the message doesn't follow conventional commit format.
 func ParseConventionalCommit(message string) *ConventionalCommit {
@@ -48,11 +116,118 @@ func ParseConventionalCommit(message string) *ConventionalCommit {
 		Scope:    matches[2],
 		Breaking: matches[3] == "!",
 		Subject:  strings.TrimSpace(matches[4]),
+		rawType:  matches[1],
+	}
+
+	if _, body, ok := strings.Cut(message, "\n"); ok {
+		cc.FooterTrailers = ParseTrailers(body)
 	}
 
 	return cc
 }
 
+// ParseTrailers parses footer trailers, such as "Signed-off-by: Jane
+// <jane@example.com>" or "Refs #123", from the final paragraph of a commit
+// body, following git's trailer rules: every line of the paragraph must be a
+// trailer or an indented continuation of the preceding one. As conventional
+// commits allow, a BREAKING CHANGE value may also continue on unindented
+// lines. Tokens that are URL schemes, as in "https://...", are not trailers.
+//
+// When the final paragraph is the whole body, it is only treated as trailers
+// if it has a token that cannot be prose: a hyphenated token such as
+// Signed-off-by, a "#" reference such as "Closes #12", or BREAKING CHANGE.
+// This keeps a body like "Note: foo" from being read as a footer.
+//
+// Repeated tokens are joined with ", ". Returns nil if there are none.
+func ParseTrailers(body string) map[string]string {
+	values := ParseTrailerValues(body)
+	if values == nil {
+		return nil
+	}
+	trailers := make(map[string]string, len(values))
+	for key, v := range values {
+		trailers[key] = strings.Join(v, ", ")
+	}
+	return trailers
+}
+
+// ParseTrailerValues parses footer trailers like ParseTrailers, but keeps
+// each occurrence of a repeated token as a separate value.
+func ParseTrailerValues(body string) map[string][]string {
+	block := strings.TrimSpace(body)
+	lone := true
+	if i := strings.LastIndex(block, "\n\n"); i >= 0 {
+		block = strings.TrimSpace(block[i+2:])
+		lone = false
+	}
+
+	var trailers map[string][]string
+	var key string
+	footerOnly := false
+	for line := range strings.SplitSeq(block, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if m := matchTrailer(line); m != nil {
+			if trailers == nil {
+				trailers = make(map[string][]string)
+			}
+			key = m[1]
+			value := strings.TrimSpace(m[3])
+			if m[2] == " #" {
+				value = "#" + value
+			}
+			trailers[key] = append(trailers[key], value)
+			if m[2] == " #" || strings.Contains(key, "-") || isBreakingToken(key) {
+				footerOnly = true
+			}
+			continue
+		}
+
+		indented := strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")
+		if key == "" || !(indented || isBreakingToken(key)) {
+			// Not a trailer block
+			return nil
+		}
+		if line != "" {
+			last := len(trailers[key]) - 1
+			trailers[key][last] += "\n" + strings.TrimSpace(line)
+		}
+	}
+
+	if lone && !footerOnly {
+		return nil
+	}
+	return trailers
+}
+
+// matchTrailer matches a trailer line, returning the trailerRegex submatches,
+// or nil if line is not a trailer. URL scheme tokens are rejected.
+func matchTrailer(line string) []string {
+	m := trailerRegex.FindStringSubmatch(line)
+	if m == nil || (m[2] != " #" && strings.HasPrefix(m[3], "//")) {
+		return nil
+	}
+	return m
+}
+
+// isBreakingToken reports whether a trailer token is BREAKING CHANGE or its
+// synonym BREAKING-CHANGE.
+func isBreakingToken(key string) bool {
+	return strings.EqualFold(key, "BREAKING CHANGE") || strings.EqualFold(key, "BREAKING-CHANGE")
+}
+
+// lookupTrailer looks up a trailer token case-insensitively.
+func lookupTrailer(trailers map[string]string, key string) (string, bool) {
+	if v, ok := trailers[key]; ok {
+		return v, true
+	}
+	for k, v := range trailers {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+	return "", false
+}
+
 // IsConventionalCommit returns true if the message follows conventional commit format.
 func IsConventionalCommit(message string) bool {
 	return ParseConventionalCommit(message) != nil
@@ -86,10 +261,10 @@ func ExtractPRNumber(subject string) int {
 	return num
 }
 
-// HasBreakingChangeMarker checks if the message body contains BREAKING CHANGE:.
+// HasBreakingChangeMarker checks if any line of the message body starts with
+// a BREAKING CHANGE (or BREAKING-CHANGE) footer token, in any paragraph.
 func HasBreakingChangeMarker(body string) bool {
-	lines := strings.Split(body, "\n")
-	for _, line := range lines {
+	for line := range strings.SplitSeq(body, "\n") {
 		if breakingChangeRegex.MatchString(line) {
 			return true
 		}
@@ -1,6 +1,7 @@
 package gitlog
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -217,6 +218,8 @@ func TestHasBreakingChangeMarker(t *testing.T) {
 		{"BREAKING-CHANGE: removes old API", true},
 		{"Breaking Change: removes old API", true},
 		{"Some text\nBREAKING CHANGE: detail\nMore text", true},
+		{"BREAKING CHANGE: removes X\n\nSigned-off-by: a <b@c>", true},
+		{"BREAKING CHANGE: detail\n\nMore explanation.", true},
 		{"No breaking changes here", false},
 		{"BREAKING NEWS: not a commit", false},
 	}
@@ -231,6 +234,89 @@ func TestHasBreakingChangeMarker(t *testing.T) {
 	}
 }
 
+func TestParseTrailers(t *testing.T) {
+	body := `Adds the new token flow.
+
+Second paragraph with Note: not a trailer
+
+Reviewed-by: Alice <alice@example.com>
+Signed-off-by: Bob <bob@example.com>
+Signed-off-by: Carol <carol@example.com>
+Refs #123
+BREAKING CHANGE: tokens now expire
+after one hour`
+
+	trailers := ParseTrailers(body)
+
+	expected := map[string]string{
+		"Reviewed-by":     "Alice <alice@example.com>",
+		"Signed-off-by":   "Bob <bob@example.com>, Carol <carol@example.com>",
+		"Refs":            "#123",
+		"BREAKING CHANGE": "tokens now expire\nafter one hour",
+	}
+	if len(trailers) != len(expected) {
+		t.Fatalf("expected %d trailers, got %d: %v", len(expected), len(trailers), trailers)
+	}
+	for k, v := range expected {
+		if trailers[k] != v {
+			t.Errorf("trailer %q: expected %q, got %q", k, v, trailers[k])
+		}
+	}
+
+	values := ParseTrailerValues(body)
+	if got := values["Signed-off-by"]; len(got) != 2 || got[0] != "Bob <bob@example.com>" || got[1] != "Carol <carol@example.com>" {
+		t.Errorf("expected two Signed-off-by values, got %q", got)
+	}
+
+	footers := ParseTrailers("BREAKING CHANGE: removes X\n\nSigned-off-by: a <b@c>")
+	if len(footers) != 1 || footers["Signed-off-by"] != "a <b@c>" {
+		t.Errorf("expected only the final paragraph's trailers, got %v", footers)
+	}
+
+	prose := "Reworks retries.\n\nNote: the old retry loop was removed.\nIt never worked.\nhttps://example.com/issue/4 has details."
+	if trailers := ParseTrailers(prose); trailers != nil {
+		t.Errorf("expected prose paragraph not to be trailers, got %v", trailers)
+	}
+	if trailers := ParseTrailers("Reworks retries.\n\nhttps://example.com/issue/4"); trailers != nil {
+		t.Errorf("expected URL line not to be a trailer, got %v", trailers)
+	}
+	if trailers := ParseTrailers("Note: foo"); trailers != nil {
+		t.Errorf("expected lone prose Note not to be a trailer, got %v", trailers)
+	}
+	if cc := ParseConventionalCommit("fix: x\n\nNote: foo"); cc.FooterTrailers != nil {
+		t.Errorf("expected no footer trailers, got %v", cc.FooterTrailers)
+	}
+	indented := ParseTrailers("Body.\n\nReviewed-by: Alice\n  and Bob\nRefs #9")
+	if indented["Reviewed-by"] != "Alice\nand Bob" || indented["Refs"] != "#9" {
+		t.Errorf("expected indented continuation, got %v", indented)
+	}
+
+	if trailers := ParseTrailers("Just a plain body.\n\nWith two paragraphs."); trailers != nil {
+		t.Errorf("expected no trailers, got %v", trailers)
+	}
+}
+
+func TestConventionalCommitGetTrailer(t *testing.T) {
+	cc := ParseConventionalCommit("feat: add tokens\n\nImplements tokens.\n\nReviewed-by: Alice\nToken #42")
+	if cc == nil {
+		t.Fatal("expected conventional commit")
+	}
+
+	if got := cc.GetTrailer("reviewed-by"); got != "Alice" {
+		t.Errorf("expected case-insensitive lookup to return Alice, got %q", got)
+	}
+	if got := cc.GetTrailer("Token"); got != "#42" {
+		t.Errorf("expected #42, got %q", got)
+	}
+	if got := cc.GetTrailer("Signed-off-by"); got != "" {
+		t.Errorf("expected empty value for missing trailer, got %q", got)
+	}
+
+	if cc := ParseConventionalCommit("fix: subject only"); cc.FooterTrailers != nil {
+		t.Errorf("expected no trailers, got %v", cc.FooterTrailers)
+	}
+}
+
 func TestIsKnownType(t *testing.T) {
 	knownTypes := []string{"feat", "fix", "docs", "style", "refactor", "perf", "test", "build", "ci", "chore", "revert", "security", "deps"}
 
@@ -255,3 +341,53 @@ func TestIsKnownType(t *testing.T) {
 		})
 	}
 }
+
+func TestConventionalCommitValidate(t *testing.T) {
+	tests := []struct {
+		message string
+		want    []error
+	}{
+		{"feat: add login", nil},
+		{"feat(auth): add OAuth support", nil},
+		{"docs: GitHub actions badge", nil},
+		{"fix: API timeout", nil},
+		{"Feat: add login", []error{ErrTypeNotLowercase}},
+		{"feat( ): add login", []error{ErrEmptyScope}},
+		{"fix: Handle nil pointer", []error{ErrSubjectNotLowercase}},
+		{"fix: handle nil pointer.", []error{ErrSubjectTrailingPeriod}},
+		{"FIX( ): Handle nil pointer.", []error{ErrTypeNotLowercase, ErrEmptyScope, ErrSubjectNotLowercase, ErrSubjectTrailingPeriod}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			cc := ParseConventionalCommit(tt.message)
+			if cc == nil {
+				t.Fatal("expected conventional commit")
+			}
+			err := cc.Validate()
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			joined, ok := err.(interface{ Unwrap() []error })
+			if !ok {
+				t.Fatalf("expected joined errors, got %v", err)
+			}
+			if n := len(joined.Unwrap()); n != len(tt.want) {
+				t.Errorf("expected %d errors, got %d: %v", len(tt.want), n, err)
+			}
+			for _, want := range tt.want {
+				if !errors.Is(err, want) {
+					t.Errorf("expected %v in %v", want, err)
+				}
+			}
+		})
+	}
+
+	cc := &ConventionalCommit{Type: "Feat", Subject: "add login"}
+	if err := cc.Validate(); !errors.Is(err, ErrTypeNotLowercase) {
+		t.Errorf("expected ErrTypeNotLowercase for constructed commit, got %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+package gitlog
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// FilterByAuthor returns a new ParseResult containing only commits whose
+// Author or AuthorEmail matches author (case-insensitive).
+func (pr *ParseResult) FilterByAuthor(author string) *ParseResult {
+	return pr.filter(func(c *Commit) bool {
+		return strings.EqualFold(c.Author, author) || strings.EqualFold(c.AuthorEmail, author)
+	})
+}
+
+// FilterByDateRange returns a new ParseResult containing only commits dated
+// between from and to inclusive. Dates use YYYY-MM-DD format; an empty bound
+// is open-ended.
+func (pr *ParseResult) FilterByDateRange(from, to string) *ParseResult {
+	return pr.filter(func(c *Commit) bool {
+		return (from == "" || c.Date >= from) && (to == "" || c.Date <= to)
+	})
+}
+
+// FilterByType returns a new ParseResult containing only commits whose
+// conventional commit type is one of types (case-insensitive).
+func (pr *ParseResult) FilterByType(types ...string) *ParseResult {
+	return pr.filter(func(c *Commit) bool {
+		return slices.ContainsFunc(types, func(t string) bool { return strings.EqualFold(c.Type, t) })
+	})
+}
+
+// filter returns a new ParseResult with the commits for which keep returns
+// true. Summary statistics and, if present, contributors are recomputed.
+func (pr *ParseResult) filter(keep func(*Commit) bool) *ParseResult {
+	result := NewParseResult()
+	result.Repository = pr.Repository
+	result.GeneratedAt = pr.GeneratedAt
+	result.Range.Since = pr.Range.Since
+	result.Range.Until = pr.Range.Until
+
+	for i := range pr.Commits {
+		if keep(&pr.Commits[i]) {
+			result.AddCommit(pr.Commits[i])
+		}
+	}
+
+	if pr.Contributors != nil {
+		result.ComputeContributors()
+	}
+	return result
+}
+
+// GroupByWeek splits the commits into new ParseResults keyed by ISO week
+// ("YYYY-WNN"), each with its own summary. See groupByDate.
+func (pr *ParseResult) GroupByWeek() map[string]*ParseResult {
+	return pr.groupByDate(GroupUnitWeek)
+}
+
+// GroupByMonth splits the commits into new ParseResults keyed by month
+// ("YYYY-MM"), each with its own summary. See groupByDate.
+func (pr *ParseResult) GroupByMonth() map[string]*ParseResult {
+	return pr.groupByDate(groupUnitMonth)
+}
+
+// groupByDate splits the commits into new ParseResults by date unit,
+// preserving commit order. Each result keeps the repository and generation
+// time; summary statistics and, if present, contributors are recomputed.
+// Commits with an unparseable date are grouped under their raw date string.
+func (pr *ParseResult) groupByDate(unit string) map[string]*ParseResult {
+	groups := make(map[string]*ParseResult)
+	for _, c := range pr.Commits {
+		key := dateGroupKey(c.Date, unit)
+		group, ok := groups[key]
+		if !ok {
+			group = NewParseResult()
+			group.Repository = pr.Repository
+			group.GeneratedAt = pr.GeneratedAt
+			groups[key] = group
+		}
+		group.AddCommit(c)
+	}
+
+	if pr.Contributors != nil {
+		for _, group := range groups {
+			group.ComputeContributors()
+		}
+	}
+	return groups
+}
+
+// Merge returns a new ParseResult with the commits of pr followed by the
+// commits of other that are not already present, matched by Hash. Summary
+// statistics and, if either result has them, contributors are recomputed.
+// The range spans from pr's Since to other's Until.
+func (pr *ParseResult) Merge(other *ParseResult) *ParseResult {
+	result := NewParseResult()
+	result.Repository = cmp.Or(other.Repository, pr.Repository)
+	result.Range.Since = pr.Range.Since
+	result.Range.Until = cmp.Or(other.Range.Until, pr.Range.Until)
+
+	seen := make(map[string]bool, len(pr.Commits)+len(other.Commits))
+	for _, commits := range [][]Commit{pr.Commits, other.Commits} {
+		for _, c := range commits {
+			if c.Hash != "" && seen[c.Hash] {
+				continue
+			}
+			seen[c.Hash] = true
+			result.AddCommit(c)
+		}
+	}
+
+	if pr.Contributors != nil || other.Contributors != nil {
+		result.ComputeContributors()
+	}
+	return result
+}
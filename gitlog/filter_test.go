@@ -0,0 +1,201 @@
+package gitlog
+
+import (
+	"testing"
+)
+
+func TestParseResult_FilterByAuthor(t *testing.T) {
+	pr := NewParseResult()
+	pr.Repository = "github.com/example/repo"
+	pr.Range.Since = "v1.0.0"
+	pr.AddCommit(Commit{ShortHash: "a1", Author: "Alice", AuthorEmail: "alice@example.com", Date: "2026-01-02",
+		Type: "feat", SuggestedCategory: "Added", FilesChanged: 2, Insertions: 10, Deletions: 1})
+	pr.AddCommit(Commit{ShortHash: "a2", Author: "Bob", AuthorEmail: "bob@example.com", Date: "2026-01-05",
+		Type: "fix", SuggestedCategory: "Fixed", FilesChanged: 1, Insertions: 3, Deletions: 3})
+	pr.AddCommit(Commit{ShortHash: "a3", Author: "Alice", AuthorEmail: "alice@example.com", Date: "2026-01-09",
+		Type: "fix", SuggestedCategory: "Fixed", FilesChanged: 4, Insertions: 20, Deletions: 5})
+	pr.AddCommit(Commit{ShortHash: "a4", Author: "Carol", AuthorEmail: "carol@example.com", Date: "2026-01-12",
+		Type: "docs", SuggestedCategory: "Documentation", FilesChanged: 1, Insertions: 1})
+	pr.ComputeContributors()
+
+	for _, author := range []string{"alice", "ALICE@example.com"} {
+		got := pr.FilterByAuthor(author)
+
+		if len(got.Commits) != 2 || got.Range.CommitCount != 2 {
+			t.Fatalf("FilterByAuthor(%q): expected 2 commits, got %d", author, len(got.Commits))
+		}
+		if got.Summary.ByType["feat"] != 1 || got.Summary.ByType["fix"] != 1 {
+			t.Errorf("unexpected ByType: %v", got.Summary.ByType)
+		}
+		if got.Summary.BySuggestedCategory["Fixed"] != 1 {
+			t.Errorf("unexpected BySuggestedCategory: %v", got.Summary.BySuggestedCategory)
+		}
+		if got.Summary.TotalFilesChanged != 6 || got.Summary.TotalInsertions != 30 || got.Summary.TotalDeletions != 6 {
+			t.Errorf("unexpected totals: %+v", got.Summary)
+		}
+		if len(got.Contributors) != 1 || got.Contributors[0].CommitCount != 2 {
+			t.Errorf("unexpected contributors: %+v", got.Contributors)
+		}
+		if got.Repository != pr.Repository || got.Range.Since != "v1.0.0" {
+			t.Error("expected repository and range to be preserved")
+		}
+	}
+
+	// Original is unchanged
+	if len(pr.Commits) != 4 || pr.Summary.ByType["fix"] != 2 {
+		t.Error("expected original result to be unchanged")
+	}
+}
+
+func TestParseResult_FilterByDateRange(t *testing.T) {
+	pr := NewParseResult()
+	pr.Repository = "github.com/example/repo"
+	pr.Range.Since = "v1.0.0"
+	pr.AddCommit(Commit{ShortHash: "a1", Author: "Alice", AuthorEmail: "alice@example.com", Date: "2026-01-02",
+		Type: "feat", SuggestedCategory: "Added", FilesChanged: 2, Insertions: 10, Deletions: 1})
+	pr.AddCommit(Commit{ShortHash: "a2", Author: "Bob", AuthorEmail: "bob@example.com", Date: "2026-01-05",
+		Type: "fix", SuggestedCategory: "Fixed", FilesChanged: 1, Insertions: 3, Deletions: 3})
+	pr.AddCommit(Commit{ShortHash: "a3", Author: "Alice", AuthorEmail: "alice@example.com", Date: "2026-01-09",
+		Type: "fix", SuggestedCategory: "Fixed", FilesChanged: 4, Insertions: 20, Deletions: 5})
+	pr.AddCommit(Commit{ShortHash: "a4", Author: "Carol", AuthorEmail: "carol@example.com", Date: "2026-01-12",
+		Type: "docs", SuggestedCategory: "Documentation", FilesChanged: 1, Insertions: 1})
+	pr.ComputeContributors()
+
+	tests := []struct {
+		name      string
+		from, to  string
+		wantCount int
+	}{
+		{"closed", "2026-01-05", "2026-01-09", 2},
+		{"open_start", "", "2026-01-05", 2},
+		{"open_end", "2026-01-09", "", 2},
+		{"unbounded", "", "", 4},
+		{"empty", "2026-02-01", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pr.FilterByDateRange(tt.from, tt.to)
+			if len(got.Commits) != tt.wantCount {
+				t.Errorf("expected %d commits, got %d", tt.wantCount, len(got.Commits))
+			}
+		})
+	}
+
+	got := pr.FilterByDateRange("2026-01-05", "2026-01-12")
+	if got.Summary.TotalInsertions != 24 || got.Summary.BySuggestedCategory["Documentation"] != 1 {
+		t.Errorf("unexpected summary: %+v", got.Summary)
+	}
+}
+
+func TestParseResult_FilterByType(t *testing.T) {
+	pr := NewParseResult()
+	pr.Repository = "github.com/example/repo"
+	pr.Range.Since = "v1.0.0"
+	pr.AddCommit(Commit{ShortHash: "a1", Author: "Alice", AuthorEmail: "alice@example.com", Date: "2026-01-02",
+		Type: "feat", SuggestedCategory: "Added", FilesChanged: 2, Insertions: 10, Deletions: 1})
+	pr.AddCommit(Commit{ShortHash: "a2", Author: "Bob", AuthorEmail: "bob@example.com", Date: "2026-01-05",
+		Type: "fix", SuggestedCategory: "Fixed", FilesChanged: 1, Insertions: 3, Deletions: 3})
+	pr.AddCommit(Commit{ShortHash: "a3", Author: "Alice", AuthorEmail: "alice@example.com", Date: "2026-01-09",
+		Type: "fix", SuggestedCategory: "Fixed", FilesChanged: 4, Insertions: 20, Deletions: 5})
+	pr.AddCommit(Commit{ShortHash: "a4", Author: "Carol", AuthorEmail: "carol@example.com", Date: "2026-01-12",
+		Type: "docs", SuggestedCategory: "Documentation", FilesChanged: 1, Insertions: 1})
+	pr.ComputeContributors()
+
+	got := pr.FilterByType("fix", "DOCS")
+
+	if len(got.Commits) != 3 {
+		t.Fatalf("expected 3 commits, got %d", len(got.Commits))
+	}
+	if _, ok := got.Summary.ByType["feat"]; ok {
+		t.Errorf("expected feat to be excluded from ByType: %v", got.Summary.ByType)
+	}
+	if got.Summary.ByType["fix"] != 2 || got.Summary.ByType["docs"] != 1 {
+		t.Errorf("unexpected ByType: %v", got.Summary.ByType)
+	}
+	if got.Summary.TotalDeletions != 8 {
+		t.Errorf("expected 8 deletions, got %d", got.Summary.TotalDeletions)
+	}
+	if len(got.Contributors) != 3 {
+		t.Errorf("expected 3 contributors, got %d", len(got.Contributors))
+	}
+}
+
+func TestParseResult_GroupByWeekAndMonth(t *testing.T) {
+	pr := NewParseResult()
+	pr.Repository = "github.com/example/repo"
+	pr.Range.Since = "v1.0.0"
+	pr.AddCommit(Commit{ShortHash: "a1", Author: "Alice", AuthorEmail: "alice@example.com", Date: "2026-01-02",
+		Type: "feat", SuggestedCategory: "Added", FilesChanged: 2, Insertions: 10, Deletions: 1})
+	pr.AddCommit(Commit{ShortHash: "a2", Author: "Bob", AuthorEmail: "bob@example.com", Date: "2026-01-05",
+		Type: "fix", SuggestedCategory: "Fixed", FilesChanged: 1, Insertions: 3, Deletions: 3})
+	pr.AddCommit(Commit{ShortHash: "a3", Author: "Alice", AuthorEmail: "alice@example.com", Date: "2026-01-09",
+		Type: "fix", SuggestedCategory: "Fixed", FilesChanged: 4, Insertions: 20, Deletions: 5})
+	pr.AddCommit(Commit{ShortHash: "a4", Author: "Carol", AuthorEmail: "carol@example.com", Date: "2026-01-12",
+		Type: "docs", SuggestedCategory: "Documentation", FilesChanged: 1, Insertions: 1})
+	pr.ComputeContributors()
+	pr.AddCommit(Commit{ShortHash: "a5", Author: "Bob", Date: "2026-02-02", Type: "feat", Insertions: 4})
+
+	weeks := pr.GroupByWeek()
+	expected := map[string]int{"2026-W01": 1, "2026-W02": 2, "2026-W03": 1, "2026-W06": 1}
+	if len(weeks) != len(expected) {
+		t.Fatalf("expected %d weeks, got %d: %v", len(expected), len(weeks), weeks)
+	}
+	for key, n := range expected {
+		if weeks[key] == nil || len(weeks[key].Commits) != n || weeks[key].Range.CommitCount != n {
+			t.Errorf("week %q: expected %d commits, got %+v", key, n, weeks[key])
+		}
+	}
+	w02 := weeks["2026-W02"]
+	if w02.Commits[0].ShortHash != "a2" || w02.Summary.ByType["fix"] != 2 || w02.Summary.TotalInsertions != 23 {
+		t.Errorf("unexpected 2026-W02 group: %+v", w02)
+	}
+	if w02.Repository != pr.Repository || len(w02.Contributors) != 2 {
+		t.Errorf("expected repository and contributors, got %q, %+v", w02.Repository, w02.Contributors)
+	}
+
+	months := pr.GroupByMonth()
+	if len(months) != 2 || len(months["2026-01"].Commits) != 4 || len(months["2026-02"].Commits) != 1 {
+		t.Fatalf("unexpected months: %v", months)
+	}
+	if months["2026-02"].Summary.ByType["feat"] != 1 || months["2026-02"].Repository != pr.Repository {
+		t.Errorf("unexpected 2026-02 group: %+v", months["2026-02"])
+	}
+}
+
+func TestParseResult_Merge(t *testing.T) {
+	existing := NewParseResult()
+	existing.Range.Since = "v1.0.0"
+	existing.Range.Until = "v1.1.0"
+	existing.AddCommit(Commit{Hash: "h1", Author: "Alice", Type: "feat", SuggestedCategory: "Added", Insertions: 5})
+	existing.AddCommit(Commit{Hash: "h2", Author: "Bob", Type: "fix", SuggestedCategory: "Fixed", Insertions: 3})
+	existing.ComputeContributors()
+
+	incoming := NewParseResult()
+	incoming.Repository = "github.com/example/repo"
+	incoming.Range.Since = "v1.1.0"
+	incoming.Range.Until = "HEAD"
+	incoming.AddCommit(Commit{Hash: "h2", Author: "Bob", Type: "fix", SuggestedCategory: "Fixed", Insertions: 3})
+	incoming.AddCommit(Commit{Hash: "h3", Author: "Alice", Type: "fix", SuggestedCategory: "Fixed", Insertions: 7})
+
+	merged := existing.Merge(incoming)
+
+	if len(merged.Commits) != 3 || merged.Range.CommitCount != 3 {
+		t.Fatalf("expected 3 deduplicated commits, got %d", len(merged.Commits))
+	}
+	if merged.Commits[2].Hash != "h3" {
+		t.Errorf("expected new commits appended, got %+v", merged.Commits)
+	}
+	if merged.Summary.ByType["fix"] != 2 || merged.Summary.TotalInsertions != 15 {
+		t.Errorf("unexpected summary: %+v", merged.Summary)
+	}
+	if merged.Range.Since != "v1.0.0" || merged.Range.Until != "HEAD" {
+		t.Errorf("unexpected range: %+v", merged.Range)
+	}
+	if merged.Repository != "github.com/example/repo" {
+		t.Errorf("unexpected repository %q", merged.Repository)
+	}
+	if len(merged.Contributors) != 2 || merged.Contributors[0].CommitCount != 2 {
+		t.Errorf("unexpected contributors: %+v", merged.Contributors)
+	}
+}
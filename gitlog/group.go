@@ -0,0 +1,90 @@
+package gitlog
+
+import (
+	"fmt"
+	"time"
+)
+
+// Date grouping units for CommitGrouper.GroupByDate.
+const (
+	GroupUnitDay  = "day"
+	GroupUnitWeek = "week"
+)
+
+// groupUnitMonth groups by month ("YYYY-MM"). It is used by
+// ParseResult.GroupByMonth and is not accepted by GroupByDate.
+const groupUnitMonth = "month"
+
+// CommitGrouper batches commits into logical groups, for example to keep
+// LLM prompts within a context window.
+type CommitGrouper struct {
+	Commits []Commit
+
+	// MaxCommitsPerGroup subdivides date groups larger than this size.
+	// Zero means no limit.
+	MaxCommitsPerGroup int
+}
+
+// NewCommitGrouper creates a CommitGrouper for the given commits.
+func NewCommitGrouper(commits []Commit) *CommitGrouper {
+	return &CommitGrouper{Commits: commits}
+}
+
+// GroupByDate groups commits by day ("YYYY-MM-DD") or ISO week ("YYYY-WNN").
+// When MaxCommitsPerGroup is set, larger groups are split into chunks keyed
+// "<key>/1", "<key>/2", and so on, preserving commit order. Commits with an
+// unparseable date are grouped under their raw date string. Returns nil for
+// an unsupported unit.
+func (g *CommitGrouper) GroupByDate(unit string) map[string][]Commit {
+	if unit != GroupUnitDay && unit != GroupUnitWeek {
+		return nil
+	}
+
+	groups := make(map[string][]Commit)
+	for _, c := range g.Commits {
+		key := dateGroupKey(c.Date, unit)
+		groups[key] = append(groups[key], c)
+	}
+
+	if g.MaxCommitsPerGroup <= 0 {
+		return groups
+	}
+
+	split := make(map[string][]Commit, len(groups))
+	for key, commits := range groups {
+		if len(commits) <= g.MaxCommitsPerGroup {
+			split[key] = commits
+			continue
+		}
+		for i, n := 0, 1; i < len(commits); i, n = i+g.MaxCommitsPerGroup, n+1 {
+			end := min(i+g.MaxCommitsPerGroup, len(commits))
+			split[fmt.Sprintf("%s/%d", key, n)] = commits[i:end]
+		}
+	}
+	return split
+}
+
+// GroupByAuthor groups commits by their Author.
+func (g *CommitGrouper) GroupByAuthor() map[string][]Commit {
+	groups := make(map[string][]Commit)
+	for _, c := range g.Commits {
+		groups[c.Author] = append(groups[c.Author], c)
+	}
+	return groups
+}
+
+// dateGroupKey returns the group key for a commit date in the given unit.
+func dateGroupKey(date, unit string) string {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return date
+	}
+	switch unit {
+	case GroupUnitWeek:
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%04d-W%02d", year, week)
+	case groupUnitMonth:
+		return t.Format("2006-01")
+	}
+	return t.Format("2006-01-02")
+}
@@ -0,0 +1,101 @@
+package gitlog
+
+import (
+	"testing"
+)
+
+func TestCommitGrouper_GroupByDate_Day(t *testing.T) {
+	commits := []Commit{
+		{ShortHash: "a1", Author: "alice", Date: "2026-01-05"}, // Monday, 2026-W02
+		{ShortHash: "a2", Author: "bob", Date: "2026-01-05"},
+		{ShortHash: "a3", Author: "alice", Date: "2026-01-05"},
+		{ShortHash: "a4", Author: "alice", Date: "2026-01-11"}, // Sunday, 2026-W02
+		{ShortHash: "a5", Author: "bob", Date: "2026-01-12"},   // Monday, 2026-W03
+	}
+
+	groups := NewCommitGrouper(commits).GroupByDate(GroupUnitDay)
+
+	expected := map[string]int{"2026-01-05": 3, "2026-01-11": 1, "2026-01-12": 1}
+	if len(groups) != len(expected) {
+		t.Fatalf("expected %d groups, got %d: %v", len(expected), len(groups), groups)
+	}
+	for key, n := range expected {
+		if len(groups[key]) != n {
+			t.Errorf("group %q: expected %d commits, got %d", key, n, len(groups[key]))
+		}
+	}
+}
+
+func TestCommitGrouper_GroupByDate_Week(t *testing.T) {
+	commits := []Commit{
+		{ShortHash: "a1", Author: "alice", Date: "2026-01-05"}, // Monday, 2026-W02
+		{ShortHash: "a2", Author: "bob", Date: "2026-01-05"},
+		{ShortHash: "a3", Author: "alice", Date: "2026-01-05"},
+		{ShortHash: "a4", Author: "alice", Date: "2026-01-11"}, // Sunday, 2026-W02
+		{ShortHash: "a5", Author: "bob", Date: "2026-01-12"},   // Monday, 2026-W03
+	}
+
+	groups := NewCommitGrouper(commits).GroupByDate(GroupUnitWeek)
+
+	if len(groups["2026-W02"]) != 4 {
+		t.Errorf("expected 4 commits in 2026-W02, got %d", len(groups["2026-W02"]))
+	}
+	if len(groups["2026-W03"]) != 1 {
+		t.Errorf("expected 1 commit in 2026-W03, got %d", len(groups["2026-W03"]))
+	}
+}
+
+func TestCommitGrouper_GroupByDate_MaxCommitsPerGroup(t *testing.T) {
+	commits := []Commit{
+		{ShortHash: "a1", Author: "alice", Date: "2026-01-05"}, // Monday, 2026-W02
+		{ShortHash: "a2", Author: "bob", Date: "2026-01-05"},
+		{ShortHash: "a3", Author: "alice", Date: "2026-01-05"},
+		{ShortHash: "a4", Author: "alice", Date: "2026-01-11"}, // Sunday, 2026-W02
+		{ShortHash: "a5", Author: "bob", Date: "2026-01-12"},   // Monday, 2026-W03
+	}
+
+	g := NewCommitGrouper(commits)
+	g.MaxCommitsPerGroup = 2
+	groups := g.GroupByDate(GroupUnitDay)
+
+	if _, ok := groups["2026-01-05"]; ok {
+		t.Error("expected oversized group to be subdivided")
+	}
+	first, second := groups["2026-01-05/1"], groups["2026-01-05/2"]
+	if len(first) != 2 || len(second) != 1 {
+		t.Fatalf("expected chunks of 2 and 1, got %d and %d", len(first), len(second))
+	}
+	if first[0].ShortHash != "a1" || second[0].ShortHash != "a3" {
+		t.Errorf("expected commit order to be preserved, got %v / %v", first, second)
+	}
+	if len(groups["2026-01-11"]) != 1 {
+		t.Error("expected small groups to keep their plain key")
+	}
+}
+
+func TestCommitGrouper_GroupByDate_UnknownUnit(t *testing.T) {
+	commits := []Commit{{ShortHash: "a1", Author: "alice", Date: "2026-01-05"}}
+
+	if groups := NewCommitGrouper(commits).GroupByDate("month"); groups != nil {
+		t.Errorf("expected nil for unsupported unit, got %v", groups)
+	}
+}
+
+func TestCommitGrouper_GroupByAuthor(t *testing.T) {
+	commits := []Commit{
+		{ShortHash: "a1", Author: "alice", Date: "2026-01-05"}, // Monday, 2026-W02
+		{ShortHash: "a2", Author: "bob", Date: "2026-01-05"},
+		{ShortHash: "a3", Author: "alice", Date: "2026-01-05"},
+		{ShortHash: "a4", Author: "alice", Date: "2026-01-11"}, // Sunday, 2026-W02
+		{ShortHash: "a5", Author: "bob", Date: "2026-01-12"},   // Monday, 2026-W03
+	}
+
+	groups := NewCommitGrouper(commits).GroupByAuthor()
+
+	if len(groups["alice"]) != 3 {
+		t.Errorf("expected 3 commits for alice, got %d", len(groups["alice"]))
+	}
+	if len(groups["bob"]) != 2 {
+		t.Errorf("expected 2 commits for bob, got %d", len(groups["bob"]))
+	}
+}
@@ -0,0 +1,38 @@
+package gitlog
+
+import (
+	"cmp"
+	"slices"
+)
+
+// ComputeFileHeatmap returns the number of commits that touched each file
+// path. File lists are only present when the parser ran with IncludeFiles,
+// so the result is empty otherwise.
+func (pr *ParseResult) ComputeFileHeatmap() map[string]int {
+	heatmap := make(map[string]int)
+	for _, c := range pr.Commits {
+		for _, f := range c.Files {
+			heatmap[f]++
+		}
+	}
+	return heatmap
+}
+
+// TopFiles returns up to n file paths touched by the most commits, in
+// descending order of touch count. Ties are ordered by path.
+func (pr *ParseResult) TopFiles(n int) []string {
+	heatmap := pr.ComputeFileHeatmap()
+
+	files := make([]string, 0, len(heatmap))
+	for f := range heatmap {
+		files = append(files, f)
+	}
+	slices.SortFunc(files, func(a, b string) int {
+		if c := cmp.Compare(heatmap[b], heatmap[a]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+
+	return files[:min(max(n, 0), len(files))]
+}
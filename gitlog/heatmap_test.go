@@ -0,0 +1,54 @@
+package gitlog
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseResult_ComputeFileHeatmap(t *testing.T) {
+	pr := NewParseResult()
+	pr.AddCommit(Commit{ShortHash: "a1", Files: []string{"main.go", "go.mod"}})
+	pr.AddCommit(Commit{ShortHash: "a2", Files: []string{"main.go", "parser.go"}})
+	pr.AddCommit(Commit{ShortHash: "a3", Files: []string{"main.go", "parser.go", "README.md"}})
+	pr.AddCommit(Commit{ShortHash: "a4"})
+
+	heatmap := pr.ComputeFileHeatmap()
+
+	want := map[string]int{"main.go": 3, "parser.go": 2, "go.mod": 1, "README.md": 1}
+	if len(heatmap) != len(want) {
+		t.Fatalf("expected %d files, got %d: %v", len(want), len(heatmap), heatmap)
+	}
+	for f, n := range want {
+		if heatmap[f] != n {
+			t.Errorf("expected %s touched %d times, got %d", f, n, heatmap[f])
+		}
+	}
+
+	if heatmap := NewParseResult().ComputeFileHeatmap(); len(heatmap) != 0 {
+		t.Errorf("expected empty heatmap, got %v", heatmap)
+	}
+}
+
+func TestParseResult_TopFiles(t *testing.T) {
+	pr := NewParseResult()
+	pr.AddCommit(Commit{ShortHash: "a1", Files: []string{"main.go", "go.mod"}})
+	pr.AddCommit(Commit{ShortHash: "a2", Files: []string{"main.go", "parser.go"}})
+	pr.AddCommit(Commit{ShortHash: "a3", Files: []string{"main.go", "parser.go", "README.md"}})
+	pr.AddCommit(Commit{ShortHash: "a4"})
+
+	tests := []struct {
+		n    int
+		want []string
+	}{
+		{2, []string{"main.go", "parser.go"}},
+		{4, []string{"main.go", "parser.go", "README.md", "go.mod"}},
+		{10, []string{"main.go", "parser.go", "README.md", "go.mod"}},
+		{0, []string{}},
+	}
+
+	for _, tt := range tests {
+		if got := pr.TopFiles(tt.n); !slices.Equal(got, tt.want) {
+			t.Errorf("TopFiles(%d) = %v, expected %v", tt.n, got, tt.want)
+		}
+	}
+}
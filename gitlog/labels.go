@@ -0,0 +1,90 @@
+package gitlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+// LabelCategories maps issue labels, such as "type: bug", to changelog
+// categories, such as "Fixed". Labels are matched case-insensitively.
+type LabelCategories map[string]string
+
+// LoadLabelCategories reads a JSON object mapping labels to categories from
+// path. See ParseLabelCategories.
+func LoadLabelCategories(path string) (LabelCategories, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read label mapping: %w", err)
+	}
+	lc, err := ParseLabelCategories(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return lc, nil
+}
+
+// ParseLabelCategories parses a JSON object mapping labels to categories,
+// e.g. {"type: bug": "Fixed", "type: feature": "Added"}. Categories are
+// normalized to their canonical names; an unknown category returns
+// changelog.ErrUnknownCategory.
+func ParseLabelCategories(data []byte) (LabelCategories, error) {
+	var raw map[string]string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse label mapping: %w", err)
+	}
+	lc := make(LabelCategories, len(raw))
+	for label, category := range raw {
+		ct := changelog.DefaultRegistry.Get(category)
+		if ct == nil {
+			return nil, fmt.Errorf("%w: %s", changelog.ErrUnknownCategory, category)
+		}
+		lc[strings.ToLower(label)] = ct.Name
+	}
+	return lc, nil
+}
+
+// CategoryFor returns the category of the first label in labels that has a
+// mapping, and whether one was found.
+func (lc LabelCategories) CategoryFor(labels []string) (string, bool) {
+	for _, label := range labels {
+		if category, ok := lc[strings.ToLower(label)]; ok {
+			return category, true
+		}
+	}
+	return "", false
+}
+
+// ApplyLabelCategories overrides the SuggestedCategory of each commit that
+// references an issue whose labels, from issueLabels keyed by issue number,
+// map to a category in lc. Summary.BySuggestedCategory is updated to match.
+// Returns the number of commits whose category changed.
+func (pr *ParseResult) ApplyLabelCategories(issueLabels map[int][]string, lc LabelCategories) int {
+	var changed int
+	for i := range pr.Commits {
+		c := &pr.Commits[i]
+		if c.Issue == 0 {
+			continue
+		}
+		category, ok := lc.CategoryFor(issueLabels[c.Issue])
+		if !ok || category == c.SuggestedCategory {
+			continue
+		}
+		if c.SuggestedCategory != "" {
+			pr.Summary.BySuggestedCategory[c.SuggestedCategory]--
+			if pr.Summary.BySuggestedCategory[c.SuggestedCategory] == 0 {
+				delete(pr.Summary.BySuggestedCategory, c.SuggestedCategory)
+			}
+		}
+		if pr.Summary.BySuggestedCategory == nil {
+			pr.Summary.BySuggestedCategory = make(map[string]int)
+		}
+		pr.Summary.BySuggestedCategory[category]++
+		c.SuggestedCategory = category
+		changed++
+	}
+	return changed
+}
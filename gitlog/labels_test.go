@@ -0,0 +1,51 @@
+package gitlog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+func TestParseLabelCategories(t *testing.T) {
+	lc, err := ParseLabelCategories([]byte(`{"Type: Bug": "Fixed", "type: feature": "Added"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, ok := lc.CategoryFor([]string{"priority: high", "type: bug"}); !ok || got != "Fixed" {
+		t.Errorf("CategoryFor = %q, %v; want Fixed, true", got, ok)
+	}
+	if _, ok := lc.CategoryFor([]string{"question"}); ok {
+		t.Error("expected no category for unmapped label")
+	}
+
+	if _, err := ParseLabelCategories([]byte(`{"type: bug": "Bugs"}`)); !errors.Is(err, changelog.ErrUnknownCategory) {
+		t.Errorf("expected ErrUnknownCategory, got %v", err)
+	}
+}
+
+func TestParseResult_ApplyLabelCategories(t *testing.T) {
+	pr := NewParseResult()
+	pr.AddCommit(Commit{ShortHash: "a1", Issue: 1, SuggestedCategory: "Changed"})
+	pr.AddCommit(Commit{ShortHash: "a2", Issue: 2, SuggestedCategory: "Added"})
+	pr.AddCommit(Commit{ShortHash: "a3", SuggestedCategory: "Changed"})
+
+	lc := LabelCategories{"type: bug": "Fixed", "type: feature": "Added"}
+	labels := map[int][]string{1: {"Type: Bug"}, 2: {"type: feature"}}
+
+	if got := pr.ApplyLabelCategories(labels, lc); got != 1 {
+		t.Errorf("expected 1 changed commit, got %d", got)
+	}
+	if pr.Commits[0].SuggestedCategory != "Fixed" || pr.Commits[2].SuggestedCategory != "Changed" {
+		t.Errorf("unexpected categories: %+v", pr.Commits)
+	}
+	want := map[string]int{"Fixed": 1, "Added": 1, "Changed": 1}
+	if len(pr.Summary.BySuggestedCategory) != len(want) {
+		t.Errorf("expected summary %v, got %v", want, pr.Summary.BySuggestedCategory)
+	}
+	for k, v := range want {
+		if pr.Summary.BySuggestedCategory[k] != v {
+			t.Errorf("expected summary %v, got %v", want, pr.Summary.BySuggestedCategory)
+		}
+	}
+}
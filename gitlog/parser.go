@@ -2,10 +2,14 @@ package gitlog
 
 import (
 	"bufio"
+	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/grokify/structured-changelog/changelog"
 )
 
 // commitDelimiter is a unique marker used to separate commits in git log output.
@@ -21,6 +25,9 @@ var numstatRegex = regexp.MustCompile(`^(\d+|-)\t(\d+|-)\t(.+)$`)
 // Parser parses git log output into structured commits.
 type Parser struct {
 	IncludeFiles bool
+
+	categoryOverrides map[string]CategorySuggestion
+	trailerKeys       []string
 }
 
 // NewParser creates a new git log parser.
@@ -30,6 +37,36 @@ func NewParser() *Parser {
 	}
 }
 
+// SetCategoryOverrides sets custom mappings from commit types to changelog
+// category names, e.g. {"story": "Added", "spike": "Internal"}. Overrides take
+// priority over the built-in mapping; types are matched case-insensitively.
+// Each category must be a name in changelog.DefaultRegistry.
+func (p *Parser) SetCategoryOverrides(overrides map[string]string) error {
+	m := make(map[string]CategorySuggestion, len(overrides))
+	for commitType, category := range overrides {
+		ct := changelog.DefaultRegistry.Get(category)
+		if ct == nil {
+			return fmt.Errorf("%w: %s", changelog.ErrUnknownCategory, category)
+		}
+		t := strings.ToLower(commitType)
+		m[t] = CategorySuggestion{
+			Category:   ct.Name,
+			Tier:       string(ct.Tier),
+			Confidence: 0.95,
+			Reasoning:  fmt.Sprintf("Category override maps commit type '%s' to %s", t, ct.Name),
+		}
+	}
+	p.categoryOverrides = m
+	return nil
+}
+
+// SetTrailerKeys sets the trailer tokens to capture into Commit.Trailers,
+// e.g. "Closes" or "Jira-Issue", matched case-insensitively. With no keys,
+// Commit.Trailers is left nil.
+func (p *Parser) SetTrailerKeys(keys ...string) {
+	p.trailerKeys = keys
+}
+
 // Parse parses git log output and returns a ParseResult.
 func (p *Parser) Parse(input string) (*ParseResult, error) {
 	result := NewParseResult()
@@ -100,8 +137,12 @@ func (p *Parser) parseCommitBlock(block string) *Commit {
 		commit.Breaking = cc.Breaking
 	}
 
-	// Check for breaking change in body
-	if !commit.Breaking && commit.Body != "" {
+	// Footer trailers, only for the requested keys
+	if len(p.trailerKeys) > 0 {
+		commit.Trailers = p.filterTrailers(ParseTrailerValues(commit.Body))
+	}
+	commit.SignedOff = hasSignedOff(commit.Body)
+	if !commit.Breaking {
 		commit.Breaking = HasBreakingChangeMarker(commit.Body)
 	}
 
@@ -115,9 +156,11 @@ func (p *Parser) parseCommitBlock(block string) *Commit {
 	}
 
 	// Suggest category
-	if suggestion := SuggestCategoryFromMessage(fullMessage); suggestion != nil {
+	suggestion, alternatives := suggestCategoryFromMessage(fullMessage, p.categoryOverrides)
+	if suggestion != nil {
 		commit.SuggestedCategory = suggestion.Category
 	}
+	commit.AlternativeCategories = suggestionCategories(alternatives)
 
 	return commit
 }
@@ -198,12 +241,51 @@ func ParseSimple(input string) (*ParseResult, error) {
 		commit.PR = ExtractPRNumber(commit.Message)
 
 		// Suggest category
-		if suggestion := SuggestCategoryFromMessage(commit.Message); suggestion != nil {
+		suggestion, alternatives := SuggestCategoryFromMessage(commit.Message)
+		if suggestion != nil {
 			commit.SuggestedCategory = suggestion.Category
 		}
+		commit.AlternativeCategories = suggestionCategories(alternatives)
 
 		result.AddCommit(*commit)
 	}
 
 	return result, scanner.Err()
 }
+
+// suggestionCategories returns the category names of the given suggestions.
+func suggestionCategories(suggestions []CategorySuggestion) []string {
+	var names []string
+	for _, s := range suggestions {
+		names = append(names, s.Category)
+	}
+	return names
+}
+
+// signedOffRegex matches a DCO Signed-off-by line.
+var signedOffRegex = regexp.MustCompile(`(?i)^Signed-off-by\s*:`)
+
+// hasSignedOff returns true if any line of body is a Signed-off-by line.
+func hasSignedOff(body string) bool {
+	for line := range strings.SplitSeq(body, "\n") {
+		if signedOffRegex.MatchString(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterTrailers returns the trailers whose tokens are in the parser's
+// trailer keys.
+func (p *Parser) filterTrailers(trailers map[string][]string) map[string][]string {
+	var filtered map[string][]string
+	for key, values := range trailers {
+		if slices.ContainsFunc(p.trailerKeys, func(k string) bool { return strings.EqualFold(k, key) }) {
+			if filtered == nil {
+				filtered = make(map[string][]string)
+			}
+			filtered[key] = values
+		}
+	}
+	return filtered
+}
@@ -1,7 +1,11 @@
 package gitlog
 
 import (
+	"errors"
+	"slices"
 	"testing"
+
+	"github.com/grokify/structured-changelog/changelog"
 )
 
 func TestParserParse(t *testing.T) {
@@ -159,6 +163,107 @@ BREAKING CHANGE: removes old method signature
 	if !result.Commits[0].Breaking {
 		t.Error("expected Breaking to be true from body marker")
 	}
+	if trailers := result.Commits[0].Trailers; trailers != nil {
+		t.Errorf("expected no trailers without trailer keys, got %v", trailers)
+	}
+}
+
+func TestParserParseBreakingChangeFooterParagraphs(t *testing.T) {
+	input := `---COMMIT_DELIMITER---
+abc123def456789012345678901234567890abcd
+abc123d
+John Doe
+john@example.com
+2026-01-04T10:30:00-08:00
+feat: change API
+
+BREAKING CHANGE: removes X
+
+Signed-off-by: John Doe <john@example.com>
+---END_BODY---
+`
+
+	parser := NewParser()
+	result, err := parser.Parse(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	commit := result.Commits[0]
+	if !commit.Breaking {
+		t.Error("expected Breaking to be true when the marker is not in the last paragraph")
+	}
+	if !commit.SignedOff {
+		t.Error("expected SignedOff to be true")
+	}
+}
+
+func TestParserParseProseNotTrailers(t *testing.T) {
+	input := `---COMMIT_DELIMITER---
+abc123def456789012345678901234567890abcd
+abc123d
+John Doe
+john@example.com
+2026-01-04T10:30:00-08:00
+fix: drop retry loop
+
+Note: the old retry loop was removed.
+It never worked.
+https://example.com/issue/4 has the details.
+---END_BODY---
+`
+
+	parser := NewParser()
+	parser.SetTrailerKeys("Note", "https")
+	result, err := parser.Parse(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if trailers := result.Commits[0].Trailers; len(trailers) != 0 {
+		t.Errorf("expected no trailers from prose, got %v", trailers)
+	}
+}
+
+func TestParserSetTrailerKeys(t *testing.T) {
+	input := `---COMMIT_DELIMITER---
+abc123def456789012345678901234567890abcd
+abc123d
+John Doe
+john@example.com
+2026-01-04T10:30:00-08:00
+fix: handle expired tokens
+
+Closes #12
+Closes #34
+jira-issue: AUTH-7
+Signed-off-by: John Doe <john@example.com>
+BREAKING CHANGE: tokens are revalidated
+---END_BODY---
+`
+
+	parser := NewParser()
+	parser.SetTrailerKeys("Closes", "Jira-Issue")
+	result, err := parser.Parse(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	commit := result.Commits[0]
+	if got := commit.Trailers["Closes"]; !slices.Equal(got, []string{"#12", "#34"}) {
+		t.Errorf("expected two Closes values, got %q", got)
+	}
+	if got := commit.Trailers["jira-issue"]; !slices.Equal(got, []string{"AUTH-7"}) {
+		t.Errorf("expected case-insensitive key match, got %q", got)
+	}
+	if len(commit.Trailers) != 2 {
+		t.Errorf("expected only requested trailers, got %v", commit.Trailers)
+	}
+	if !commit.Breaking {
+		t.Error("expected Breaking to be detected even when its trailer is not captured")
+	}
+	if !commit.SignedOff {
+		t.Error("expected SignedOff to be detected even when its trailer is not captured")
+	}
 }
 
 func TestParserParseNoFiles(t *testing.T) {
@@ -186,6 +291,48 @@ feat: add feature
 	}
 }
 
+func TestParserSetCategoryOverrides(t *testing.T) {
+	input := `---COMMIT_DELIMITER---
+abc123def456789012345678901234567890abcd
+abc123d
+John Doe
+john@example.com
+2026-01-04T10:30:00-08:00
+story: add checkout page
+---END_BODY---
+---COMMIT_DELIMITER---
+def456abc789012345678901234567890abcdef
+def456a
+Jane Smith
+jane@example.com
+2026-01-03T15:00:00-08:00
+chore: bump build tooling
+---END_BODY---
+`
+
+	parser := NewParser()
+	if err := parser.SetCategoryOverrides(map[string]string{"Story": "Added", "chore": "Build"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result, err := parser.Parse(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.Commits[0].SuggestedCategory != "Added" {
+		t.Errorf("expected override category Added, got %s", result.Commits[0].SuggestedCategory)
+	}
+	// Overrides take priority over the built-in mapping (chore -> Internal)
+	if result.Commits[1].SuggestedCategory != "Build" {
+		t.Errorf("expected override category Build, got %s", result.Commits[1].SuggestedCategory)
+	}
+
+	err = parser.SetCategoryOverrides(map[string]string{"epic": "Features"})
+	if !errors.Is(err, changelog.ErrUnknownCategory) {
+		t.Errorf("expected ErrUnknownCategory, got %v", err)
+	}
+}
+
 func TestParserParseEmptyInput(t *testing.T) {
 	parser := NewParser()
 	result, err := parser.Parse("")
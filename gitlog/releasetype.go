@@ -0,0 +1,31 @@
+package gitlog
+
+import (
+	"strings"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+// Release types suggested by SuggestReleaseType, following semver.
+const (
+	ReleaseTypeMajor = changelog.ReleaseTypeMajor
+	ReleaseTypeMinor = changelog.ReleaseTypeMinor
+	ReleaseTypePatch = changelog.ReleaseTypePatch
+)
+
+// SuggestReleaseType suggests the semver release type for the parsed commits:
+// "major" if any commit is breaking, "minor" if any commit has type feat, and
+// "patch" otherwise.
+func (pr *ParseResult) SuggestReleaseType() string {
+	releaseType := ReleaseTypePatch
+	for i := range pr.Commits {
+		c := &pr.Commits[i]
+		if c.Breaking {
+			return ReleaseTypeMajor
+		}
+		if strings.EqualFold(c.Type, "feat") {
+			releaseType = ReleaseTypeMinor
+		}
+	}
+	return releaseType
+}
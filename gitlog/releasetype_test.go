@@ -0,0 +1,28 @@
+package gitlog
+
+import "testing"
+
+func TestParseResult_SuggestReleaseType(t *testing.T) {
+	tests := []struct {
+		name    string
+		commits []Commit
+		want    string
+	}{
+		{"empty", nil, ReleaseTypePatch},
+		{"fixes", []Commit{{Type: "fix"}, {Type: "docs"}}, ReleaseTypePatch},
+		{"feature", []Commit{{Type: "fix"}, {Type: "feat"}}, ReleaseTypeMinor},
+		{"breaking", []Commit{{Type: "feat"}, {Type: "fix", Breaking: true}}, ReleaseTypeMajor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := NewParseResult()
+			for _, c := range tt.commits {
+				pr.AddCommit(c)
+			}
+			if got := pr.SuggestReleaseType(); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
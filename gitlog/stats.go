@@ -0,0 +1,49 @@
+package gitlog
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// CommitStats holds file change statistics for a single commit.
+type CommitStats struct {
+	FilesChanged int      `json:"filesChanged"`
+	Insertions   int      `json:"insertions"`
+	Deletions    int      `json:"deletions"`
+	Files        []string `json:"files,omitempty"`
+}
+
+// GetCommitStats returns file change statistics for the commit with the given
+// hash, using "git show --numstat". This complements the stats populated by
+// Parser when the commit was not part of a parsed range.
+func GetCommitStats(hash string) (*CommitStats, error) {
+	if hash == "" {
+		return nil, fmt.Errorf("%w: hash", ErrEmptyRef)
+	}
+
+	cmd := exec.Command("git", "show", "--numstat", "--format=", hash)
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("git show failed: %s", string(exitErr.Stderr))
+		}
+		return nil, fmt.Errorf("failed to run git show: %w", err)
+	}
+
+	return parseCommitStats(string(output)), nil
+}
+
+// parseCommitStats parses numstat output into CommitStats.
+func parseCommitStats(numstat string) *CommitStats {
+	var commit Commit
+	NewParser().parseNumstat(&commit, strings.TrimSpace(numstat))
+	return &CommitStats{
+		FilesChanged: commit.FilesChanged,
+		Insertions:   commit.Insertions,
+		Deletions:    commit.Deletions,
+		Files:        commit.Files,
+	}
+}
@@ -0,0 +1,84 @@
+package gitlog
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseCommitStats(t *testing.T) {
+	stats := parseCommitStats("10\t2\tmain.go\n-\t-\tlogo.png\n3\t0\tREADME.md\n")
+
+	if stats.FilesChanged != 3 || stats.Insertions != 13 || stats.Deletions != 2 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+	if len(stats.Files) != 3 || stats.Files[1] != "logo.png" {
+		t.Errorf("unexpected files: %v", stats.Files)
+	}
+}
+
+func TestGetCommitStats(t *testing.T) {
+	dir, git := initTestRepo(t)
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("one\ntwo\nthree\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	git("add", "a.txt")
+	git("commit", "-q", "-m", "feat: add a")
+
+	if err := os.WriteFile(filepath.Join(dir, "a.txt"), []byte("one\n2\nthree\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "b.txt"), []byte("new\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	git("add", "a.txt", "b.txt")
+	git("commit", "-q", "-m", "fix: update a, add b")
+	hash := git("rev-parse", "HEAD")
+
+	stats, err := GetCommitStats(hash)
+	if err != nil {
+		t.Fatalf("GetCommitStats failed: %v", err)
+	}
+	if stats.FilesChanged != 2 || stats.Insertions != 2 || stats.Deletions != 1 {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+	if len(stats.Files) != 2 || stats.Files[0] != "a.txt" || stats.Files[1] != "b.txt" {
+		t.Errorf("unexpected files: %v", stats.Files)
+	}
+
+	if _, err := GetCommitStats("deadbeef"); err == nil {
+		t.Error("expected error for unknown commit")
+	}
+	if _, err := GetCommitStats(""); !errors.Is(err, ErrEmptyRef) {
+		t.Errorf("expected ErrEmptyRef, got %v", err)
+	}
+}
+
+// initTestRepo creates an empty git repository in a temporary directory,
+// changes into it, and returns it with a helper that runs git commands there.
+// The test is skipped if git is not available.
+func initTestRepo(t *testing.T) (string, func(args ...string) string) {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	git := func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-c", "user.name=Test", "-c", "user.email=test@example.com"}, args...)...)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v failed: %v\n%s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+
+	git("init", "-q")
+	return dir, git
+}
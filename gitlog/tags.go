@@ -13,11 +13,14 @@ import (
 // Tag represents a git tag with metadata.
 type Tag struct {
 	Name        string    `json:"name"`
+	Ref         string    `json:"ref,omitempty"` // Full git tag name when a prefix was stripped from Name
 	Date        time.Time `json:"date"`
 	DateString  string    `json:"dateString"`
 	CommitHash  string    `json:"commitHash"`
 	CommitCount int       `json:"commitCount,omitempty"` // Commits since previous tag
 	IsInitial   bool      `json:"isInitial,omitempty"`   // True if this is the first tag
+	Annotated   bool      `json:"annotated,omitempty"`   // True for annotated tags (git tag -a)
+	TagMessage  string    `json:"tagMessage,omitempty"`  // Annotated tag message
 }
 
 // TagList represents a list of tags with metadata.
@@ -33,8 +36,109 @@ var semverRegex = regexp.MustCompile(`^v?(\d+)\.(\d+)\.(\d+)`)
 
 // GetTags returns all semver tags in the repository sorted by version.
 func GetTags() (*TagList, error) {
-	// Get all tags
-	cmd := exec.Command("git", "tag", "--list")
+	return getTags("", "")
+}
+
+// GetTagsByPattern returns tags matching a git glob pattern, such as
+// "sdk/go/*", sorted by version. Tags are kept if the part of the name after
+// the last "/" is a semver version; Tag.Name holds the full tag name.
+func GetTagsByPattern(glob string) (*TagList, error) {
+	return getTags(glob, "")
+}
+
+// GetTagsByPrefix returns tags starting with prefix, such as "sdk/go/",
+// sorted by version. The prefix is stripped from Tag.Name so that the
+// remainder is compared as a semver version; Tag.Ref holds the full tag name.
+func GetTagsByPrefix(prefix string) (*TagList, error) {
+	return getTags(prefix+"*", prefix)
+}
+
+// GetTagsInDateRange returns the semver tags, sorted by version, whose Date
+// is within from and to inclusive. A zero from or to leaves that end of the
+// range open. CommitCount is relative to the previous tag overall, which
+// may be outside the range.
+func GetTagsInDateRange(from, to time.Time) (*TagList, error) {
+	tagList, err := GetTags()
+	if err != nil {
+		return nil, err
+	}
+	return tagList.FilterByDate(from, to), nil
+}
+
+// GetTagsSinceDate returns the semver tags, sorted by version, dated on or
+// after d. See GetTagsInDateRange.
+func GetTagsSinceDate(d time.Time) (*TagList, error) {
+	return GetTagsInDateRange(d, time.Time{})
+}
+
+// FilterByDate returns a copy of the list with only the tags for which
+// InDateRange(from, to) is true.
+func (tl *TagList) FilterByDate(from, to time.Time) *TagList {
+	filtered := *tl
+	filtered.Tags = []Tag{}
+	for _, tag := range tl.Tags {
+		if tag.InDateRange(from, to) {
+			filtered.Tags = append(filtered.Tags, tag)
+		}
+	}
+	filtered.TotalTags = len(filtered.Tags)
+	return &filtered
+}
+
+// InDateRange reports whether the tag's Date is within from and to
+// inclusive. A zero from or to leaves that end of the range open.
+func (t Tag) InDateRange(from, to time.Time) bool {
+	if !from.IsZero() && t.Date.Before(from) {
+		return false
+	}
+	if !to.IsZero() && t.Date.After(to) {
+		return false
+	}
+	return true
+}
+
+// GetTagsSince returns the semver tags that point to commits in ref..HEAD,
+// i.e. reachable from HEAD but not from ref, in git's version:refname order.
+// The tag named by ref itself is excluded. CommitCount is not set.
+func GetTagsSince(ref string) ([]Tag, error) {
+	output, err := exec.Command("git", "rev-list", ref+"..HEAD").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits since %s: %w", ref, err)
+	}
+	commits := make(map[string]bool)
+	for _, hash := range strings.Fields(string(output)) {
+		commits[hash] = true
+	}
+
+	output, err = exec.Command("git", "tag", "--list", "--sort=version:refname").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	tags := []Tag{}
+	for _, name := range strings.Fields(string(output)) {
+		if !semverRegex.MatchString(name) {
+			continue
+		}
+		tag, err := getTagMetadata(name)
+		if err != nil {
+			continue // Skip tags we can't get metadata for
+		}
+		if commits[tag.CommitHash] {
+			tags = append(tags, *tag)
+		}
+	}
+	return tags, nil
+}
+
+// getTags lists tags matching pattern (all tags if empty), strips prefix from
+// their names, and returns the semver tags sorted by version.
+func getTags(pattern, prefix string) (*TagList, error) {
+	args := []string{"tag", "--list"}
+	if pattern != "" {
+		args = append(args, pattern)
+	}
+	cmd := exec.Command("git", args...)
 	output, err := cmd.Output()
 	if err != nil {
 		return nil, fmt.Errorf("failed to list tags: %w", err)
@@ -53,14 +157,17 @@ func GetTags() (*TagList, error) {
 	var semverTags []string
 	for _, tag := range tagNames {
 		tag = strings.TrimSpace(tag)
-		if tag != "" && semverRegex.MatchString(tag) {
+		version, ok := tagVersion(tag, pattern, prefix)
+		if ok && tag != "" && semverRegex.MatchString(version) {
 			semverTags = append(semverTags, tag)
 		}
 	}
 
 	// Sort by semver
 	sort.Slice(semverTags, func(i, j int) bool {
-		return compareSemver(semverTags[i], semverTags[j]) < 0
+		vi, _ := tagVersion(semverTags[i], pattern, prefix)
+		vj, _ := tagVersion(semverTags[j], pattern, prefix)
+		return compareSemver(vi, vj) < 0
 	})
 
 	// Get metadata for each tag
@@ -70,6 +177,10 @@ func GetTags() (*TagList, error) {
 		if err != nil {
 			continue // Skip tags we can't get metadata for
 		}
+		if prefix != "" {
+			tag.Ref = tagName
+			tag.Name = strings.TrimPrefix(tagName, prefix)
+		}
 
 		// Calculate commit count since previous tag
 		if i == 0 {
@@ -93,34 +204,73 @@ func GetTags() (*TagList, error) {
 	}, nil
 }
 
-// getTagMetadata retrieves date and commit hash for a tag.
+// tagVersion returns the version part of a tag name: the remainder after
+// prefix if one is given, the last path segment if pattern is set, and the
+// whole name otherwise. It returns false if the tag lacks the prefix.
+func tagVersion(tag, pattern, prefix string) (string, bool) {
+	switch {
+	case prefix != "":
+		return strings.CutPrefix(tag, prefix)
+	case pattern != "":
+		return tag[strings.LastIndex(tag, "/")+1:], true
+	default:
+		return tag, true
+	}
+}
+
+// tagRefFormat is the git for-each-ref format used to read tag metadata.
+// Fields are separated by the ASCII unit separator (%1f). For annotated tags
+// the %(*...) fields describe the tagged commit; for lightweight tags they are
+// empty and the unprefixed fields describe the commit itself.
+const tagRefFormat = "%(objecttype)%1f%(objectname)%1f%(*objectname)%1f" +
+	"%(taggerdate:iso-strict)%1f%(authordate:iso-strict)%1f%(*authordate:iso-strict)%1f" +
+	"%(contents:subject)%1f%(contents:body)"
+
+// getTagMetadata retrieves date, commit hash, and annotation for a tag.
+// Annotated tags use the tagger date; lightweight tags use the commit's
+// author date.
 func getTagMetadata(tagName string) (*Tag, error) {
-	// Get commit hash
-	hashCmd := exec.Command("git", "rev-list", "-n", "1", tagName)
-	hashOutput, err := hashCmd.Output()
+	cmd := exec.Command("git", "for-each-ref", "--format="+tagRefFormat, "refs/tags/"+tagName)
+	output, err := cmd.Output()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get hash for tag %s: %w", tagName, err)
+		return nil, fmt.Errorf("failed to read tag %s: %w", tagName, err)
 	}
 
-	// Get commit date
-	dateCmd := exec.Command("git", "log", "-1", "--format=%aI", tagName)
-	dateOutput, err := dateCmd.Output()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get date for tag %s: %w", tagName, err)
+	return parseTagRef(tagName, string(output))
+}
+
+// parseTagRef parses a single line of for-each-ref output in tagRefFormat.
+func parseTagRef(tagName, output string) (*Tag, error) {
+	fields := strings.Split(strings.TrimRight(output, "\n"), "\x1f")
+	if len(fields) != 8 {
+		return nil, fmt.Errorf("unexpected tag metadata for %s", tagName)
+	}
+	objectType, objectName, peeledName := fields[0], fields[1], fields[2]
+	taggerDate, authorDate, peeledAuthorDate := fields[3], fields[4], fields[5]
+
+	tag := &Tag{Name: tagName}
+	var dateStr string
+	if objectType == "tag" {
+		tag.Annotated = true
+		tag.CommitHash = peeledName
+		tag.TagMessage = strings.TrimSpace(fields[6] + "\n\n" + fields[7])
+		dateStr = taggerDate
+		if dateStr == "" {
+			dateStr = peeledAuthorDate
+		}
+	} else {
+		tag.CommitHash = objectName
+		dateStr = authorDate
 	}
 
-	dateStr := strings.TrimSpace(string(dateOutput))
 	date, err := time.Parse(time.RFC3339, dateStr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse date for tag %s: %w", tagName, err)
 	}
+	tag.Date = date
+	tag.DateString = date.Format("2006-01-02")
 
-	return &Tag{
-		Name:       tagName,
-		Date:       date,
-		DateString: date.Format("2006-01-02"),
-		CommitHash: strings.TrimSpace(string(hashOutput)),
-	}, nil
+	return tag, nil
 }
 
 // countCommits counts commits between two refs.
@@ -197,6 +347,15 @@ type VersionRange struct {
 	Commits int    `json:"commits"` // Commit count in range
 }
 
+// GitRef returns the git ref for the tag: Ref if a prefix was stripped,
+// otherwise Name.
+func (t Tag) GitRef() string {
+	if t.Ref != "" {
+		return t.Ref
+	}
+	return t.Name
+}
+
 // GetAllVersionRanges returns all version ranges for parsing commits.
 func GetAllVersionRanges() ([]VersionRange, error) {
 	tagList, err := GetTags()
@@ -1,8 +1,11 @@
 package gitlog
 
 import (
+	"fmt"
+	"slices"
 	"sort"
 	"testing"
+	"time"
 )
 
 func TestCompareSemver(t *testing.T) {
@@ -168,3 +171,182 @@ func TestVersionRangeStructure(t *testing.T) {
 		t.Errorf("expected Commits=10, got %d", vr.Commits)
 	}
 }
+
+func TestParseTagRef(t *testing.T) {
+	tests := []struct {
+		name          string
+		output        string
+		wantAnnotated bool
+		wantHash      string
+		wantDate      string
+		wantMessage   string
+	}{
+		{
+			name:     "lightweight",
+			output:   "commit\x1fabc123\x1f\x1f\x1f2024-01-15T10:00:00+00:00\x1f\x1fInitial commit\x1f\n",
+			wantHash: "abc123",
+			wantDate: "2024-01-15",
+		},
+		{
+			name:          "annotated",
+			output:        "tag\x1ftag999\x1fdef456\x1f2024-03-05T09:00:00+01:00\x1f\x1f2024-02-01T10:00:00+00:00\x1fRelease 1.1.0\x1fHighlights\n\n",
+			wantAnnotated: true,
+			wantHash:      "def456",
+			wantDate:      "2024-03-05",
+			wantMessage:   "Release 1.1.0\n\nHighlights",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tag, err := parseTagRef("v1.0.0", tt.output)
+			if err != nil {
+				t.Fatalf("parseTagRef failed: %v", err)
+			}
+			if tag.Annotated != tt.wantAnnotated {
+				t.Errorf("Annotated = %v, expected %v", tag.Annotated, tt.wantAnnotated)
+			}
+			if tag.CommitHash != tt.wantHash {
+				t.Errorf("CommitHash = %q, expected %q", tag.CommitHash, tt.wantHash)
+			}
+			if tag.DateString != tt.wantDate {
+				t.Errorf("DateString = %q, expected %q", tag.DateString, tt.wantDate)
+			}
+			if tag.TagMessage != tt.wantMessage {
+				t.Errorf("TagMessage = %q, expected %q", tag.TagMessage, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestParseTagRef_Malformed(t *testing.T) {
+	if _, err := parseTagRef("v1.0.0", "commit\x1fabc123\n"); err == nil {
+		t.Error("expected error for malformed output")
+	}
+}
+
+func TestGetTagsSince(t *testing.T) {
+	_, git := initTestRepo(t)
+
+	for i, tag := range []string{"v1.0.0", "deploy-1", "v1.1.0", "v1.10.0", "v1.2.0"} {
+		git("commit", "-q", "--allow-empty", "-m", fmt.Sprintf("commit %d", i))
+		git("tag", "-a", tag, "-m", tag)
+	}
+	deploy := git("rev-parse", "deploy-1^{commit}")
+	git("commit", "-q", "--allow-empty", "-m", "untagged")
+
+	tags, err := GetTagsSince("deploy-1")
+	if err != nil {
+		t.Fatalf("GetTagsSince failed: %v", err)
+	}
+	var names []string
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	if !slices.Equal(names, []string{"v1.1.0", "v1.2.0", "v1.10.0"}) {
+		t.Errorf("unexpected tags since deploy-1: %v", names)
+	}
+
+	if tags, err := GetTagsSince(deploy); err != nil || len(tags) != 3 {
+		t.Errorf("expected 3 tags since commit hash, got %v, %v", tags, err)
+	}
+	if tags, err := GetTagsSince("HEAD"); err != nil || len(tags) != 0 {
+		t.Errorf("expected no tags since HEAD, got %v, %v", tags, err)
+	}
+	if _, err := GetTagsSince("no-such-ref"); err == nil {
+		t.Error("expected error for unknown ref")
+	}
+}
+
+func TestGetTagsByPatternAndPrefix(t *testing.T) {
+	_, git := initTestRepo(t)
+
+	for i, tag := range []string{"v1.0.0", "sdk/go/v1.0.0", "sdk/go/v1.2.0", "sdk/go/v1.10.0", "sdk/py/v2.0.0"} {
+		git("commit", "-q", "--allow-empty", "-m", fmt.Sprintf("commit %d", i))
+		git("tag", tag)
+	}
+
+	byPattern, err := GetTagsByPattern("sdk/go/*")
+	if err != nil {
+		t.Fatalf("GetTagsByPattern failed: %v", err)
+	}
+	if got := tagNames(byPattern); !slices.Equal(got, []string{"sdk/go/v1.0.0", "sdk/go/v1.2.0", "sdk/go/v1.10.0"}) {
+		t.Errorf("unexpected tags by pattern: %v", got)
+	}
+
+	byPrefix, err := GetTagsByPrefix("sdk/go/")
+	if err != nil {
+		t.Fatalf("GetTagsByPrefix failed: %v", err)
+	}
+	if got := tagNames(byPrefix); !slices.Equal(got, []string{"v1.0.0", "v1.2.0", "v1.10.0"}) {
+		t.Errorf("unexpected tags by prefix: %v", got)
+	}
+	last := byPrefix.Tags[2]
+	if last.Ref != "sdk/go/v1.10.0" || last.GitRef() != "sdk/go/v1.10.0" {
+		t.Errorf("expected full ref to be kept, got %q", last.Ref)
+	}
+	if last.CommitCount != 1 {
+		t.Errorf("expected 1 commit since previous prefixed tag, got %d", last.CommitCount)
+	}
+
+	all, err := GetTags()
+	if err != nil {
+		t.Fatalf("GetTags failed: %v", err)
+	}
+	if all.TotalTags != 1 || all.Tags[0].GitRef() != "v1.0.0" {
+		t.Errorf("expected only the unprefixed semver tag, got %v", tagNames(all))
+	}
+}
+
+func tagNames(tl *TagList) []string {
+	var names []string
+	for _, tag := range tl.Tags {
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+func TestGetTagsInDateRange(t *testing.T) {
+	_, git := initTestRepo(t)
+
+	for _, c := range []struct{ tag, date string }{
+		{"v1.0.0", "2024-01-15T12:00:00Z"},
+		{"v1.1.0", "2024-06-01T12:00:00Z"},
+		{"v1.2.0", "2024-12-31T12:00:00Z"},
+		{"v2.0.0", "2025-03-01T12:00:00Z"},
+	} {
+		git("commit", "-q", "--allow-empty", "--date="+c.date, "-m", c.tag)
+		git("tag", c.tag)
+	}
+
+	names := func(tl *TagList) []string {
+		var names []string
+		for _, tag := range tl.Tags {
+			names = append(names, tag.Name)
+		}
+		return names
+	}
+
+	from := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 12, 31, 12, 0, 0, 0, time.UTC)
+	tl, err := GetTagsInDateRange(from, to)
+	if err != nil {
+		t.Fatalf("GetTagsInDateRange failed: %v", err)
+	}
+	if got := names(tl); !slices.Equal(got, []string{"v1.1.0", "v1.2.0"}) || tl.TotalTags != 2 {
+		t.Errorf("expected inclusive range v1.1.0, v1.2.0, got %v (total %d)", got, tl.TotalTags)
+	}
+
+	tl, err = GetTagsSinceDate(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("GetTagsSinceDate failed: %v", err)
+	}
+	if got := names(tl); !slices.Equal(got, []string{"v1.2.0", "v2.0.0"}) {
+		t.Errorf("expected v1.2.0, v2.0.0, got %v", got)
+	}
+
+	tl, err = GetTagsInDateRange(time.Time{}, time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil || len(tl.Tags) != 0 {
+		t.Errorf("expected no tags before 2023, got %v, %v", tl, err)
+	}
+}
@@ -0,0 +1,108 @@
+package renderer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grokify/structured-changelog/changelog"
+	"github.com/grokify/structured-locale/messages"
+)
+
+// emailDivider is the ASCII divider line used to frame email release notes.
+var emailDivider = strings.Repeat("=", 72)
+
+// RenderEmail renders a single release as a plain-text email body.
+// The output contains no Markdown syntax: category headers are upper-cased,
+// entries are indented list items, and security identifiers are placed on
+// continuation lines. If the changelog has a supported repository, a footer
+// with the compare URL for the release is appended. Headers and labels are
+// localized using opts.Locale and opts.LocaleOverrides.
+// Returns changelog.ErrVersionNotFound if the version does not exist.
+func RenderEmail(cl *changelog.Changelog, version string, opts Options) (string, error) {
+	idx := cl.ReleaseIndex(version)
+	if idx < 0 {
+		return "", fmt.Errorf("%w: %s", changelog.ErrVersionNotFound, version)
+	}
+	r := &cl.Releases[idx]
+	l := getLocalizer(opts)
+
+	var sb strings.Builder
+
+	// Title block
+	title := version
+	if cl.Project != "" {
+		title = cl.Project + " " + version
+	}
+	if r.Date != "" {
+		title += " (" + r.Date + ")"
+	}
+	if r.Yanked {
+		title += " [" + l.T("section.yanked") + "]"
+	}
+	sb.WriteString(emailDivider + "\n")
+	sb.WriteString(title + "\n")
+	sb.WriteString(emailDivider + "\n")
+
+	// Categories
+	for _, cat := range r.Categories() {
+		sb.WriteString("\n" + strings.ToUpper(localizedCategoryName(l, cat.Name)) + "\n\n")
+		for _, e := range cat.Entries {
+			renderEmailEntry(&sb, &e, l)
+		}
+	}
+
+	// Footer with compare URL
+	if url := releaseCompareURL(cl, idx); url != "" {
+		sb.WriteString("\n" + emailDivider + "\n")
+		sb.WriteString(l.Tf("email.full_changelog", map[string]any{"URL": url}) + "\n")
+	}
+
+	return sb.String(), nil
+}
+
+// renderEmailEntry writes a single entry as an indented plain-text list item.
+func renderEmailEntry(sb *strings.Builder, e *changelog.Entry, l *messages.Localizer) {
+	line := e.Description
+	if e.Breaking {
+		line = l.T("marker.breaking") + " " + line
+	}
+
+	var refs []string
+	if e.Issue != "" {
+		refs = append(refs, "#"+strings.TrimPrefix(e.Issue, "#"))
+	}
+	if e.PR != "" {
+		refs = append(refs, "#"+strings.TrimPrefix(e.PR, "#"))
+	}
+	if len(refs) > 0 {
+		line += " (" + strings.Join(refs, ", ") + ")"
+	}
+
+	sb.WriteString("  - " + line + "\n")
+
+	// Security details on continuation lines
+	if e.CVE != "" {
+		sb.WriteString("    " + l.Tf("email.cve", map[string]any{"ID": e.CVE}) + "\n")
+	}
+	if e.GHSA != "" {
+		sb.WriteString("    " + l.Tf("email.ghsa", map[string]any{"ID": e.GHSA}) + "\n")
+	}
+	if e.Severity != "" {
+		sb.WriteString("    " + l.Tf("email.severity", map[string]any{"Severity": e.Severity}) + "\n")
+	}
+}
+
+// releaseCompareURL returns the compare URL for the release at index idx,
+// comparing against the next older release. The oldest release links to its tag.
+// Returns an empty string if the repository host is not supported.
+func releaseCompareURL(cl *changelog.Changelog, idx int) string {
+	repo := changelog.ParseRepoURL(cl.Repository)
+	if !repo.Known() {
+		return ""
+	}
+	version := cl.Releases[idx].Version
+	if idx == len(cl.Releases)-1 {
+		return repo.TagURL(cl.TagPath, version)
+	}
+	return repo.CompareURL(cl.TagPath, cl.Releases[idx+1].Version, version)
+}
@@ -0,0 +1,142 @@
+package renderer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+func TestRenderEmail(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion:  "1.0",
+		Project:    "test-project",
+		Repository: "https://github.com/example/repo",
+		Releases: []changelog.Release{
+			{
+				Version: "v1.1.0",
+				Date:    "2026-02-01",
+				Added:   []changelog.Entry{{Description: "New feature", PR: "42"}},
+				Fixed:   []changelog.Entry{{Description: "Bug fix", Issue: "#7"}},
+				Security: []changelog.Entry{{
+					Description: "Fix XSS",
+					CVE:         "CVE-2026-12345",
+					GHSA:        "GHSA-abcd-1234-efgh",
+				}},
+			},
+			{
+				Version: "v1.0.0",
+				Date:    "2026-01-01",
+				Added:   []changelog.Entry{{Description: "Initial release"}},
+			},
+		},
+	}
+
+	out, err := RenderEmail(cl, "v1.1.0", DefaultOptions())
+	if err != nil {
+		t.Fatalf("RenderEmail failed: %v", err)
+	}
+
+	expected := []string{
+		emailDivider,
+		"test-project v1.1.0 (2026-02-01)",
+		"\nADDED\n",
+		"\nFIXED\n",
+		"\nSECURITY\n",
+		"  - New feature (#42)\n",
+		"  - Bug fix (#7)\n",
+		"  - Fix XSS\n    CVE: CVE-2026-12345\n    GHSA: GHSA-abcd-1234-efgh\n",
+		"Full changelog: https://github.com/example/repo/compare/v1.0.0...v1.1.0",
+	}
+	for _, want := range expected {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	// No Markdown syntax
+	for _, md := range []string{"##", "**", "]("} {
+		if strings.Contains(out, md) {
+			t.Errorf("expected no Markdown syntax %q in output, got:\n%s", md, out)
+		}
+	}
+}
+
+func TestRenderEmail_OldestReleaseLinksTag(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion:  "1.0",
+		Project:    "test",
+		Repository: "https://github.com/example/repo",
+		Releases: []changelog.Release{
+			{Version: "v1.0.0", Date: "2026-01-01", Added: []changelog.Entry{{Description: "Initial"}}},
+		},
+	}
+
+	out, err := RenderEmail(cl, "v1.0.0", DefaultOptions())
+	if err != nil {
+		t.Fatalf("RenderEmail failed: %v", err)
+	}
+	if !strings.Contains(out, "Full changelog: https://github.com/example/repo/releases/tag/v1.0.0") {
+		t.Errorf("expected tag link footer, got:\n%s", out)
+	}
+}
+
+func TestRenderEmail_NoRepository(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{Version: "1.0.0", Date: "2026-01-01", Added: []changelog.Entry{{Description: "Initial"}}},
+		},
+	}
+
+	out, err := RenderEmail(cl, "1.0.0", DefaultOptions())
+	if err != nil {
+		t.Fatalf("RenderEmail failed: %v", err)
+	}
+	if strings.Contains(out, "Full changelog") {
+		t.Errorf("expected no footer without repository, got:\n%s", out)
+	}
+}
+
+func TestRenderEmail_VersionNotFound(t *testing.T) {
+	cl := &changelog.Changelog{IRVersion: "1.0", Project: "test"}
+
+	_, err := RenderEmail(cl, "9.9.9", DefaultOptions())
+	if !errors.Is(err, changelog.ErrVersionNotFound) {
+		t.Errorf("expected ErrVersionNotFound, got %v", err)
+	}
+}
+
+func TestRenderEmail_Localized(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion:  "1.0",
+		Project:    "test",
+		Repository: "https://github.com/example/repo",
+		Releases: []changelog.Release{
+			{
+				Version:  "v1.0.0",
+				Date:     "2026-01-01",
+				Security: []changelog.Entry{{Description: "Fix XSS", CVE: "CVE-2026-12345", Severity: "high"}},
+			},
+		},
+	}
+
+	out, err := RenderEmail(cl, "v1.0.0", DefaultOptions().WithLocale("fr"))
+	if err != nil {
+		t.Fatalf("RenderEmail failed: %v", err)
+	}
+
+	expected := []string{
+		"\nSÉCURITÉ\n",
+		"    CVE : CVE-2026-12345\n",
+		"    Gravité : high\n",
+		"Journal des modifications complet : https://github.com/example/repo/releases/tag/v1.0.0",
+	}
+	for _, want := range expected {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package renderer
+
+import "github.com/grokify/structured-changelog/changelog"
+
+// CategoryEmoji maps canonical category names to the emoji used for category
+// headers when Options.EmojiHeaders is enabled. Categories without an entry
+// are rendered without an emoji.
+var CategoryEmoji = map[string]string{
+	changelog.CategoryHighlights:     "✨",
+	changelog.CategoryBreaking:       "💥",
+	changelog.CategoryUpgradeGuide:   "⬆️",
+	changelog.CategorySecurity:       "🔒",
+	changelog.CategoryAdded:          "🚀",
+	changelog.CategoryChanged:        "🔄",
+	changelog.CategoryDeprecated:     "⚠️",
+	changelog.CategoryRemoved:        "🗑️",
+	changelog.CategoryFixed:          "🐛",
+	changelog.CategoryPerformance:    "⚡",
+	changelog.CategoryDependencies:   "📦",
+	changelog.CategoryDocumentation:  "📝",
+	changelog.CategoryBuild:          "🏗️",
+	changelog.CategoryTests:          "🧪",
+	changelog.CategoryInfrastructure: "🏭",
+	changelog.CategoryObservability:  "📈",
+	changelog.CategoryCompliance:     "📋",
+	changelog.CategoryInternal:       "🔧",
+	changelog.CategoryKnownIssues:    "🚧",
+	changelog.CategoryContributors:   "👥",
+}
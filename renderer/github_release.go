@@ -0,0 +1,119 @@
+package renderer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+// RenderGitHubReleaseNotes renders a single release as a GitHub Releases body.
+// It follows GitHub's generated release notes conventions: a "What's Changed"
+// header, entries attributed as "by @author in #PR", a "New Contributors"
+// section for external authors with no entries in older releases, and a
+// "**Full Changelog**" compare link when the repository host is supported.
+// Returns changelog.ErrVersionNotFound if the version does not exist.
+func RenderGitHubReleaseNotes(cl *changelog.Changelog, version string) (string, error) {
+	idx := cl.ReleaseIndex(version)
+	if idx < 0 {
+		return "", fmt.Errorf("%w: %s", changelog.ErrVersionNotFound, version)
+	}
+	r := &cl.Releases[idx]
+	l := defaultBundle.Localizer("en")
+
+	var sb strings.Builder
+	sb.WriteString("## What's Changed\n")
+
+	// newContributors holds first-time external authors in order of appearance;
+	// firstRefs maps each (lowercased) to the reference of their first entry.
+	var newContributors []string
+	firstRefs := make(map[string]string)
+	priorAuthors := authorsInReleases(cl.Releases[idx+1:])
+
+	for _, cat := range r.Categories() {
+		fmt.Fprintf(&sb, "\n### %s\n\n", localizedCategoryName(l, cat.Name))
+		for _, e := range cat.Entries {
+			sb.WriteString("- " + githubReleaseEntryLine(&e, l.T("marker.breaking")) + "\n")
+
+			if e.Author == "" || cl.IsTeamMember(e.Author) {
+				continue
+			}
+			name := strings.TrimPrefix(e.Author, "@")
+			key := strings.ToLower(name)
+			if priorAuthors[key] {
+				continue
+			}
+			if _, seen := firstRefs[key]; !seen {
+				newContributors = append(newContributors, name)
+				firstRefs[key] = githubEntryRef(&e)
+			}
+		}
+	}
+
+	if len(newContributors) > 0 {
+		sb.WriteString("\n### New Contributors\n\n")
+		for _, name := range newContributors {
+			line := "- @" + name + " made their first contribution"
+			if ref := firstRefs[strings.ToLower(name)]; ref != "" {
+				line += " in " + ref
+			}
+			sb.WriteString(line + "\n")
+		}
+	}
+
+	if url := releaseCompareURL(cl, idx); url != "" {
+		sb.WriteString("\n**Full Changelog**: " + url + "\n")
+	}
+
+	return sb.String(), nil
+}
+
+// githubReleaseEntryLine formats an entry in GitHub's "description by @author in #PR" style.
+func githubReleaseEntryLine(e *changelog.Entry, breakingMarker string) string {
+	desc := e.Description
+	if e.Author != "" {
+		desc = stripInlineAttribution(desc, e.Author)
+	}
+	if e.Breaking {
+		desc = "**" + breakingMarker + "** " + desc
+	}
+	if e.Author != "" {
+		desc += " by @" + strings.TrimPrefix(e.Author, "@")
+	}
+	if ref := githubEntryRef(e); ref != "" {
+		desc += " in " + ref
+	}
+	return desc
+}
+
+// githubEntryRef returns the PR reference for an entry, falling back to the
+// issue reference. GitHub auto-links "#123" in release bodies.
+func githubEntryRef(e *changelog.Entry) string {
+	ref := e.PR
+	if ref == "" {
+		ref = e.Issue
+	}
+	if ref == "" {
+		return ""
+	}
+	if strings.HasPrefix(ref, "http://") || strings.HasPrefix(ref, "https://") {
+		return ref
+	}
+	return "#" + strings.TrimPrefix(ref, "#")
+}
+
+// authorsInReleases returns the set of lowercased author names (without "@")
+// that appear in any entry of the given releases.
+func authorsInReleases(releases []changelog.Release) map[string]bool {
+	authors := make(map[string]bool)
+	for i := range releases {
+		for _, cat := range releases[i].Categories() {
+			for _, e := range cat.Entries {
+				if e.Author != "" {
+					authors[strings.ToLower(strings.TrimPrefix(e.Author, "@"))] = true
+				}
+			}
+		}
+	}
+	return authors
+}
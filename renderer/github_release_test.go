@@ -0,0 +1,101 @@
+package renderer
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+func TestRenderGitHubReleaseNotes(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion:   "1.0",
+		Project:     "test-project",
+		Repository:  "https://github.com/example/repo",
+		Maintainers: []string{"maintainer"},
+		Releases: []changelog.Release{
+			{
+				Version: "v1.1.0",
+				Date:    "2026-02-01",
+				Added: []changelog.Entry{
+					{Description: "New feature", PR: "42", Author: "@newbie"},
+					{Description: "Another feature", PR: "43", Author: "maintainer"},
+				},
+				Changed: []changelog.Entry{
+					{Description: "API rename", PR: "44", Author: "returning", Breaking: true},
+				},
+				Fixed: []changelog.Entry{{Description: "Bug fix", Issue: "#7"}},
+			},
+			{
+				Version: "v1.0.0",
+				Date:    "2026-01-01",
+				Added:   []changelog.Entry{{Description: "Initial release", Author: "returning"}},
+			},
+		},
+	}
+
+	out, err := RenderGitHubReleaseNotes(cl, "v1.1.0")
+	if err != nil {
+		t.Fatalf("RenderGitHubReleaseNotes failed: %v", err)
+	}
+
+	expected := []string{
+		"## What's Changed\n",
+		"### Added\n",
+		"- New feature by @newbie in #42\n",
+		"- Another feature by @maintainer in #43\n",
+		"- **BREAKING:** API rename by @returning in #44\n",
+		"- Bug fix in #7\n",
+		"### New Contributors\n\n- @newbie made their first contribution in #42\n",
+		"**Full Changelog**: https://github.com/example/repo/compare/v1.0.0...v1.1.0\n",
+	}
+	for _, want := range expected {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	// Maintainers and returning contributors are not new contributors
+	for _, name := range []string{"@maintainer made", "@returning made"} {
+		if strings.Contains(out, name) {
+			t.Errorf("did not expect %q in new contributors, got:\n%s", name, out)
+		}
+	}
+
+	// New Contributors must come after the categories and before the footer
+	ncIdx := strings.Index(out, "### New Contributors")
+	if ncIdx < strings.Index(out, "### Fixed") || ncIdx > strings.Index(out, "**Full Changelog**") {
+		t.Errorf("New Contributors section is out of order:\n%s", out)
+	}
+}
+
+func TestRenderGitHubReleaseNotes_NoRepository(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{Version: "1.0.0", Date: "2026-01-01", Added: []changelog.Entry{{Description: "Initial"}}},
+		},
+	}
+
+	out, err := RenderGitHubReleaseNotes(cl, "1.0.0")
+	if err != nil {
+		t.Fatalf("RenderGitHubReleaseNotes failed: %v", err)
+	}
+	if strings.Contains(out, "Full Changelog") {
+		t.Errorf("expected no footer without repository, got:\n%s", out)
+	}
+	if strings.Contains(out, "New Contributors") {
+		t.Errorf("expected no New Contributors section, got:\n%s", out)
+	}
+}
+
+func TestRenderGitHubReleaseNotes_VersionNotFound(t *testing.T) {
+	cl := &changelog.Changelog{IRVersion: "1.0", Project: "test"}
+
+	_, err := RenderGitHubReleaseNotes(cl, "9.9.9")
+	if !errors.Is(err, changelog.ErrVersionNotFound) {
+		t.Errorf("expected ErrVersionNotFound, got %v", err)
+	}
+}
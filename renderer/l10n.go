@@ -1,7 +1,11 @@
 package renderer
 
 import (
+	"bytes"
 	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
 	"strings"
 
@@ -12,14 +16,19 @@ import (
 var defaultLocales embed.FS
 
 // defaultBundle holds embedded default translations.
-var defaultBundle *messages.Bundle
+var defaultBundle = newDefaultBundle()
 
-func init() {
-	defaultBundle = messages.NewBundle("en")
+// ErrInvalidLocaleOverrides is returned when a locale override file does not
+// match schema/locale-overrides-v1.schema.json.
+var ErrInvalidLocaleOverrides = errors.New("invalid locale overrides")
+
+// newDefaultBundle returns a bundle with the embedded default translations.
+func newDefaultBundle() *messages.Bundle {
+	bundle := messages.NewBundle("en")
 
 	entries, err := defaultLocales.ReadDir("locales")
 	if err != nil {
-		return
+		return bundle
 	}
 
 	for _, e := range entries {
@@ -33,11 +42,15 @@ func init() {
 		}
 
 		loc := strings.TrimSuffix(e.Name(), ".json")
-		_ = defaultBundle.AddLocale(loc, data)
+		_ = bundle.AddLocale(loc, data)
 	}
+	return bundle
 }
 
-// getLocalizer returns a localizer for the given options.
+// getLocalizer returns a localizer for the given options. Locale overrides
+// are merged into a copy of the default bundle, so they apply only to this
+// render. An unreadable or invalid override file is ignored; use
+// LoadLocaleOverrides or OptionsFromConfig to report it.
 func getLocalizer(opts Options) *messages.Localizer {
 	locale := opts.Locale
 	if locale == "" {
@@ -45,15 +58,63 @@ func getLocalizer(opts Options) *messages.Localizer {
 	}
 
 	if opts.LocaleOverrides != "" {
-		data, err := os.ReadFile(opts.LocaleOverrides)
-		if err == nil {
-			_ = defaultBundle.AddLocaleOverrides(locale, data)
+		if data, err := LoadLocaleOverrides(opts.LocaleOverrides); err == nil {
+			bundle := newDefaultBundle()
+			if err := bundle.AddLocaleOverrides(locale, data); err == nil {
+				return bundle.Localizer(locale)
+			}
 		}
 	}
 
 	return defaultBundle.Localizer(locale)
 }
 
+// LoadLocaleOverrides reads a locale override file and validates it with
+// ValidateLocaleOverrides, returning its contents.
+func LoadLocaleOverrides(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read locale overrides: %w", err)
+	}
+	if err := ValidateLocaleOverrides(data); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return data, nil
+}
+
+// ValidateLocaleOverrides checks locale override JSON against the format in
+// schema/locale-overrides-v1.schema.json: a "messages" array whose entries
+// have a built-in message ID (see docs/guides/localization.md) and a
+// non-empty translation, given as an object with at least an "other" form
+// for plural messages. An override file may contain any subset of messages.
+func ValidateLocaleOverrides(data []byte) error {
+	var mf messages.MessagesFile
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&mf); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidLocaleOverrides, err)
+	}
+	if mf.Messages == nil {
+		return fmt.Errorf("%w: missing \"messages\" array", ErrInvalidLocaleOverrides)
+	}
+
+	for i := range mf.Messages {
+		m := &mf.Messages[i]
+		builtin := defaultBundle.GetMessage("en", m.ID)
+		if builtin == nil {
+			return fmt.Errorf("%w: messages[%d]: unknown message ID %q", ErrInvalidLocaleOverrides, i, m.ID)
+		}
+		if builtin.IsPlural() {
+			if p := m.GetPlural(); p == nil || p.Other == "" {
+				return fmt.Errorf("%w: messages[%d]: %s requires plural forms with \"other\"", ErrInvalidLocaleOverrides, i, m.ID)
+			}
+		} else if s, ok := m.Translation.(string); !ok || s == "" {
+			return fmt.Errorf("%w: messages[%d]: %s requires a non-empty string translation", ErrInvalidLocaleOverrides, i, m.ID)
+		}
+	}
+	return nil
+}
+
 // categoryToMessageID converts a changelog category name to a message ID.
 // For example, "Added" -> "category.added", "Known Issues" -> "category.known_issues".
 func categoryToMessageID(category string) string {
@@ -62,3 +123,14 @@ func categoryToMessageID(category string) string {
 	id = strings.ReplaceAll(id, " ", "_")
 	return "category." + id
 }
+
+// localizedCategoryName returns the translated display name for a category,
+// falling back to the canonical name when no translation exists.
+func localizedCategoryName(l *messages.Localizer, category string) string {
+	msgID := categoryToMessageID(category)
+	name := l.T(msgID)
+	if name == msgID {
+		return category
+	}
+	return name
+}
@@ -1,6 +1,9 @@
 package renderer
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -186,3 +189,145 @@ func TestRenderYankedWithLocale(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderUnreleasedWithLocale(t *testing.T) {
+	cl := &changelog.Changelog{
+		Unreleased: &changelog.Release{
+			Added: []changelog.Entry{{Description: "Work in progress"}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		locale   string
+		contains string
+	}{
+		{"english unreleased", "en", "## [Unreleased]"},
+		{"french unreleased", "fr", "## [Non publié]"},
+		{"german unreleased", "de", "## [Unveröffentlicht]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultOptions().WithLocale(tt.locale)
+			md := RenderMarkdownWithOptions(cl, opts)
+
+			if !strings.Contains(md, tt.contains) {
+				t.Errorf("Expected output to contain %q for locale %q.\nOutput:\n%s",
+					tt.contains, tt.locale, md)
+			}
+		})
+	}
+}
+
+func TestLocalizedCategoryName(t *testing.T) {
+	tests := []struct {
+		locale   string
+		category string
+		expected string
+	}{
+		{"en", "Added", "Added"},
+		{"fr", "Added", "Ajouté"},
+		{"fr", "Known Issues", "Problèmes connus"},
+		{"en", "Custom Category", "Custom Category"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale+"/"+tt.category, func(t *testing.T) {
+			l := getLocalizer(DefaultOptions().WithLocale(tt.locale))
+			if got := localizedCategoryName(l, tt.category); got != tt.expected {
+				t.Errorf("localizedCategoryName(%q) = %q, expected %q", tt.category, got, tt.expected)
+			}
+		})
+	}
+}
+
+func writeLocaleOverrides(t *testing.T, data string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "overrides.json")
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRenderMarkdownWithLocaleOverrides(t *testing.T) {
+	cl := &changelog.Changelog{
+		Releases: []changelog.Release{
+			{
+				Version: "1.0.0",
+				Date:    "2024-01-15",
+				Added:   []changelog.Entry{{Description: "New feature"}},
+				Fixed:   []changelog.Entry{{Description: "Bug fix"}},
+			},
+		},
+	}
+	path := writeLocaleOverrides(t, `{"messages": [
+		{"id": "changelog.title", "translation": "Release Notes"},
+		{"id": "category.added", "translation": "Nouveautés"}
+	]}`)
+
+	md := RenderMarkdownWithOptions(cl, DefaultOptions().WithLocale("fr").WithLocaleOverrides(path))
+	for _, want := range []string{"# Release Notes\n", "### Nouveautés\n", "### Corrigé\n"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, md)
+		}
+	}
+
+	// Overrides must not leak into later renders without them
+	md = RenderMarkdownWithOptions(cl, DefaultOptions().WithLocale("fr"))
+	if strings.Contains(md, "Release Notes") || strings.Contains(md, "Nouveautés") {
+		t.Errorf("expected overrides not to persist, got:\n%s", md)
+	}
+}
+
+func TestValidateLocaleOverrides(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{"partial", `{"messages": [{"id": "section.yanked", "translation": "PULLED"}]}`, false},
+		{"empty", `{"messages": []}`, false},
+		{"plural", `{"messages": [{"id": "plural.releases", "translation": {"one": "{{.Count}} version", "other": "{{.Count}} versions"}}]}`, false},
+		{"missing messages", `{}`, true},
+		{"unknown field", `{"messages": [], "locale": "fr"}`, true},
+		{"unknown id", `{"messages": [{"id": "category.misc", "translation": "Misc"}]}`, true},
+		{"empty translation", `{"messages": [{"id": "marker.breaking", "translation": ""}]}`, true},
+		{"plural without other", `{"messages": [{"id": "plural.releases", "translation": {"one": "1 version"}}]}`, true},
+		{"string for plural", `{"messages": [{"id": "plural.releases", "translation": "versions"}]}`, true},
+		{"invalid json", `{"messages": [`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLocaleOverrides([]byte(tt.data))
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidLocaleOverrides) {
+					t.Errorf("expected ErrInvalidLocaleOverrides, got %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestOptionsFromConfig_LocaleOverrides(t *testing.T) {
+	path := writeLocaleOverrides(t, `{"messages": [{"id": "category.added", "translation": "New"}]}`)
+	opts, err := OptionsFromConfig(Config{LocaleOverrides: path})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.LocaleOverrides != path {
+		t.Errorf("expected LocaleOverrides %q, got %q", path, opts.LocaleOverrides)
+	}
+
+	path = writeLocaleOverrides(t, `{"messages": [{"id": "category.new", "translation": "New"}]}`)
+	if _, err := OptionsFromConfig(Config{LocaleOverrides: path}); !errors.Is(err, ErrInvalidLocaleOverrides) {
+		t.Errorf("expected ErrInvalidLocaleOverrides, got %v", err)
+	}
+	if _, err := OptionsFromConfig(Config{LocaleOverrides: filepath.Join(t.TempDir(), "missing.json")}); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
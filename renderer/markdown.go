@@ -2,21 +2,18 @@
 package renderer
 
 import (
+	"cmp"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/grokify/structured-changelog/changelog"
 	"github.com/grokify/structured-locale/messages"
 )
 
-// githubRepoPattern matches GitHub repository URLs and extracts owner/repo.
-var githubRepoPattern = regexp.MustCompile(`^https?://github\.com/([^/]+)/([^/]+?)(?:\.git)?/?$`)
-
-// gitlabRepoPattern matches GitLab repository URLs and extracts the full project path.
-// GitLab supports nested groups, so the path can be arbitrarily deep.
-var gitlabRepoPattern = regexp.MustCompile(`^https?://gitlab\.com/(.+?)(?:\.git)?/?$`)
-
 // RenderMarkdown renders a changelog to Keep a Changelog formatted Markdown.
 // The output is deterministic: same input always produces identical output.
 func RenderMarkdown(cl *changelog.Changelog) string {
@@ -80,26 +77,22 @@ func renderHeaderLine(cl *changelog.Changelog, l *messages.Localizer) string {
 
 // renderContext holds context needed during rendering.
 type renderContext struct {
-	cl      *changelog.Changelog
-	opts    Options
-	baseURL string
-	host    repoHost
-	l       *messages.Localizer
+	cl   *changelog.Changelog
+	opts Options
+	repo changelog.RepoURL
+	l    *messages.Localizer
 }
 
 // RenderMarkdownWithOptions renders a changelog with custom options.
 func RenderMarkdownWithOptions(cl *changelog.Changelog, opts Options) string {
 	var sb strings.Builder
 
-	// Parse repository for linking
-	baseURL, host := parseRepository(cl.Repository)
 	l := getLocalizer(opts)
 	ctx := renderContext{
-		cl:      cl,
-		opts:    opts,
-		baseURL: baseURL,
-		host:    host,
-		l:       l,
+		cl:   cl,
+		opts: opts,
+		repo: changelog.ParseRepoURL(cl.Repository), // for linking
+		l:    l,
 	}
 
 	// Filter releases if NotableOnly is enabled
@@ -108,6 +101,13 @@ func RenderMarkdownWithOptions(cl *changelog.Changelog, opts Options) string {
 		releases = filterNotableReleases(cl.Releases, opts.NotabilityPolicy)
 	}
 
+	// Truncate to the most recent releases if MaxReleases is set
+	totalReleases := len(releases)
+	linkReleases := releases
+	if opts.MaxReleases > 0 {
+		releases = limitReleases(releases, opts.MaxReleases, opts.CompactMaintenanceReleases)
+	}
+
 	// Header
 	sb.WriteString("# " + l.T("changelog.title") + "\n\n")
 	sb.WriteString(l.T("changelog.intro") + "\n\n")
@@ -116,10 +116,10 @@ func RenderMarkdownWithOptions(cl *changelog.Changelog, opts Options) string {
 	// Unreleased section
 	// Always show if IncludeUnreleasedLink is enabled and there are releases to compare against
 	if cl.Unreleased != nil && !cl.Unreleased.IsEmpty() {
-		sb.WriteString("\n## [" + l.T("section.unreleased") + "]\n")
+		sb.WriteString("\n## " + versionHeading(l.T("section.unreleased"), cl.Unreleased.CompareURL, ctx) + "\n")
 		renderReleaseContent(&sb, cl.Unreleased, ctx)
 	} else if opts.IncludeUnreleasedLink && len(releases) > 0 {
-		sb.WriteString("\n## [" + l.T("section.unreleased") + "]\n")
+		sb.WriteString("\n## " + versionHeading(l.T("section.unreleased"), "", ctx) + "\n")
 	}
 
 	// Releases
@@ -132,14 +132,20 @@ func RenderMarkdownWithOptions(cl *changelog.Changelog, opts Options) string {
 		}
 	}
 
+	if len(releases) < totalReleases {
+		sb.WriteString("\n")
+		sb.WriteString(renderTruncationFooter(cl, len(releases), totalReleases, l))
+	}
+
 	// Reference links at bottom (for GitHub repositories)
-	// Use filtered releases for links when NotableOnly is enabled
-	if opts.IncludeCompareLinks && cl.Repository != "" {
+	// Use filtered releases for links when NotableOnly is enabled.
+	// Plain headers have no reference-style links to resolve.
+	if opts.IncludeCompareLinks && cl.Repository != "" && opts.VersionLinkStyle != VersionLinkStylePlain {
 		var links string
-		if opts.NotableOnly {
-			links = renderReferenceLinksForReleases(cl, releases, opts.IncludeUnreleasedLink)
+		if opts.NotableOnly || len(releases) < totalReleases {
+			links = renderReferenceLinksForReleases(cl, linkReleases, opts.IncludeUnreleasedLink, len(releases), opts.VersionPrefix)
 		} else {
-			links = renderReferenceLinks(cl, opts.IncludeUnreleasedLink)
+			links = renderReferenceLinks(cl, opts.IncludeUnreleasedLink, opts.VersionPrefix)
 		}
 		if links != "" {
 			sb.WriteString("\n")
@@ -147,9 +153,146 @@ func RenderMarkdownWithOptions(cl *changelog.Changelog, opts Options) string {
 		}
 	}
 
+	if opts.IncludeContributorsSection {
+		if section := renderContributorsSection(cl, ctx); section != "" {
+			sb.WriteString("\n")
+			sb.WriteString(section)
+		}
+	}
+
+	return sb.String()
+}
+
+// contributor is an entry author and the number of entries they authored.
+type contributor struct {
+	name  string
+	count int
+}
+
+// renderContributorsSection renders a "## Contributors" section listing each
+// unique entry author in the Unreleased section and all releases, most
+// entries first, with a profile link when the repository host is known.
+// Authors are matched case-insensitively, ignoring a leading "@". Returns ""
+// if no entry has an author.
+func renderContributorsSection(cl *changelog.Changelog, ctx renderContext) string {
+	var contributors []contributor
+	index := make(map[string]int)
+	addRelease := func(r *changelog.Release) {
+		for _, cat := range r.Categories() {
+			for _, e := range cat.Entries {
+				if e.Author == "" {
+					continue
+				}
+				name := strings.TrimPrefix(e.Author, "@")
+				key := strings.ToLower(name)
+				if i, ok := index[key]; ok {
+					contributors[i].count++
+					continue
+				}
+				index[key] = len(contributors)
+				contributors = append(contributors, contributor{name: name, count: 1})
+			}
+		}
+	}
+	if cl.Unreleased != nil {
+		addRelease(cl.Unreleased)
+	}
+	for i := range cl.Releases {
+		addRelease(&cl.Releases[i])
+	}
+	if len(contributors) == 0 {
+		return ""
+	}
+
+	slices.SortStableFunc(contributors, func(a, b contributor) int {
+		if c := cmp.Compare(b.count, a.count); c != 0 {
+			return c
+		}
+		return cmp.Compare(strings.ToLower(a.name), strings.ToLower(b.name))
+	})
+
+	var sb strings.Builder
+	sb.WriteString("## " + localizedCategoryName(ctx.l, changelog.CategoryContributors) + "\n\n")
+	for _, c := range contributors {
+		sb.WriteString(fmt.Sprintf("- %s (%d)\n", authorProfileLink(c.name, ctx), c.count))
+	}
 	return sb.String()
 }
 
+// RenderMarkdownEntry renders a single release as flat Markdown with no
+// version header: each category is a "**Added:**" bold label followed by its
+// entries as a bulleted list. This suits embedding a release inline in a PR
+// description or issue comment; see RenderMarkdownSection for a version with
+// headers. Pass "unreleased" to render the Unreleased section. Returns
+// changelog.ErrVersionNotFound if the version does not exist.
+//
+// This is a renderer function rather than a changelog.Release method because
+// the changelog package cannot import renderer Options.
+func RenderMarkdownEntry(cl *changelog.Changelog, version string, opts Options) (string, error) {
+	var r *changelog.Release
+	if strings.EqualFold(version, "unreleased") {
+		r = cl.Unreleased
+	} else if idx := cl.ReleaseIndex(version); idx >= 0 {
+		r = &cl.Releases[idx]
+	}
+	if r == nil {
+		return "", fmt.Errorf("%w: %s", changelog.ErrVersionNotFound, version)
+	}
+
+	ctx := renderContext{
+		cl:   cl,
+		opts: opts,
+		repo: changelog.ParseRepoURL(cl.Repository),
+		l:    getLocalizer(opts),
+	}
+
+	var sb strings.Builder
+	for _, cat := range releaseCategories(r, ctx) {
+		if len(cat.Entries) == 0 {
+			continue
+		}
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, "**%s:**\n\n", localizedCategoryName(ctx.l, cat.Name))
+		for _, entry := range cat.Entries {
+			renderEntry(&sb, &entry, ctx, cat.Name)
+		}
+	}
+	return sb.String(), nil
+}
+
+// RenderMarkdownSection renders a single release as a Markdown section with a
+// "## [version] - date" header and its category sub-sections, omitting the
+// "# Changelog" header and reference links. This suits embedding release notes
+// in a PR description. Pass "unreleased" to render the Unreleased section.
+// Returns changelog.ErrVersionNotFound if the version does not exist.
+func RenderMarkdownSection(cl *changelog.Changelog, version string, opts Options) (string, error) {
+	ctx := renderContext{
+		cl:   cl,
+		opts: opts,
+		repo: changelog.ParseRepoURL(cl.Repository),
+		l:    getLocalizer(opts),
+	}
+
+	var sb strings.Builder
+	if strings.EqualFold(version, "unreleased") {
+		if cl.Unreleased == nil {
+			return "", fmt.Errorf("%w: %s", changelog.ErrVersionNotFound, version)
+		}
+		sb.WriteString("## " + versionHeading(ctx.l.T("section.unreleased"), cl.Unreleased.CompareURL, ctx) + "\n")
+		renderReleaseContent(&sb, cl.Unreleased, ctx)
+		return sb.String(), nil
+	}
+
+	idx := cl.ReleaseIndex(version)
+	if idx < 0 {
+		return "", fmt.Errorf("%w: %s", changelog.ErrVersionNotFound, version)
+	}
+	renderRelease(&sb, &cl.Releases[idx], ctx)
+	return sb.String(), nil
+}
+
 // filterNotableReleases filters releases to include only those that are notable
 // according to the given policy.
 func filterNotableReleases(releases []changelog.Release, policy *changelog.NotabilityPolicy) []changelog.Release {
@@ -167,6 +310,38 @@ func filterNotableReleases(releases []changelog.Release, policy *changelog.Notab
 	return notable
 }
 
+// limitReleases returns the most recent releases up to maxReleases. When
+// compact is set, a run of consecutive maintenance-only releases is rendered
+// as a single section, so it counts once toward the limit and is never split.
+func limitReleases(releases []changelog.Release, maxReleases int, compact bool) []changelog.Release {
+	if !compact {
+		return releases[:min(maxReleases, len(releases))]
+	}
+
+	sections, i := 0, 0
+	for i < len(releases) && sections < maxReleases {
+		if releases[i].IsMaintenanceOnly() {
+			for i < len(releases) && releases[i].IsMaintenanceOnly() {
+				i++
+			}
+		} else {
+			i++
+		}
+		sections++
+	}
+	return releases[:i]
+}
+
+// renderTruncationFooter renders the note shown when MaxReleases hides older
+// releases, linking to the repository when one is set.
+func renderTruncationFooter(cl *changelog.Changelog, shown, total int, l *messages.Localizer) string {
+	footer := "> " + l.Tf("footer.showing_releases", map[string]any{"Shown": shown, "Total": total})
+	if cl.Repository != "" {
+		footer += " " + l.Tf("footer.full_changelog", map[string]any{"URL": cl.Repository})
+	}
+	return footer + "\n"
+}
+
 func renderRelease(sb *strings.Builder, r *changelog.Release, ctx renderContext) {
 	// Version header
 	var commitSuffix string
@@ -174,10 +349,20 @@ func renderRelease(sb *strings.Builder, r *changelog.Release, ctx renderContext)
 		commitSuffix = " (" + formatCommitRef(r.Commit, ctx) + ")"
 	}
 
+	if ctx.opts.IncludeHighlightsFirst {
+		renderHighlightsCallout(sb, r, ctx)
+	}
+
+	var bumpSuffix string
+	if ctx.opts.ShowVersionBump {
+		bumpSuffix = " *(" + versionBump(r) + ")*"
+	}
+
+	heading := versionHeading(changelog.PrefixVersion(r.Version, ctx.opts.VersionPrefix), r.CompareURL, ctx)
 	if r.Yanked {
-		fmt.Fprintf(sb, "## [%s] - %s%s [%s]\n", r.Version, r.Date, commitSuffix, ctx.l.T("section.yanked"))
+		fmt.Fprintf(sb, "## %s%s%s%s [%s]\n", heading, dateSuffix(r.Date, ctx), bumpSuffix, commitSuffix, ctx.l.T("section.yanked"))
 	} else {
-		fmt.Fprintf(sb, "## [%s] - %s%s\n", r.Version, r.Date, commitSuffix)
+		fmt.Fprintf(sb, "## %s%s%s%s\n", heading, dateSuffix(r.Date, ctx), bumpSuffix, commitSuffix)
 	}
 
 	renderReleaseContent(sb, r, ctx)
@@ -221,7 +406,7 @@ func renderReleasesWithGrouping(sb *strings.Builder, releases []changelog.Releas
 func renderMaintenanceRelease(sb *strings.Builder, r *changelog.Release, ctx renderContext) {
 	l := ctx.l
 	// Compact header with (Maintenance) suffix
-	fmt.Fprintf(sb, "## [%s] - %s (%s)\n\n", r.Version, r.Date, l.T("marker.maintenance"))
+	fmt.Fprintf(sb, "## %s%s (%s)\n\n", versionHeading(changelog.PrefixVersion(r.Version, ctx.opts.VersionPrefix), r.CompareURL, ctx), dateSuffix(r.Date, ctx), l.T("marker.maintenance"))
 
 	// Summarize what changed
 	var types []string
@@ -273,8 +458,8 @@ func renderMaintenanceGroup(sb *strings.Builder, releases []changelog.Release, c
 
 	sb.WriteString("\n")
 	versionsRange := l.Tf("marker.versions_range", map[string]any{
-		"From": oldest.Version,
-		"To":   newest.Version,
+		"From": changelog.PrefixVersion(oldest.Version, ctx.opts.VersionPrefix),
+		"To":   changelog.PrefixVersion(newest.Version, ctx.opts.VersionPrefix),
 	})
 	fmt.Fprintf(sb, "## %s (%s)\n\n", versionsRange, l.T("marker.maintenance"))
 
@@ -312,24 +497,158 @@ func renderMaintenanceGroup(sb *strings.Builder, releases []changelog.Release, c
 }
 
 func renderReleaseContent(sb *strings.Builder, r *changelog.Release, ctx renderContext) {
-	// Render categories in canonical order, filtered by tier
+	for _, cat := range releaseCategories(r, ctx) {
+		if cat.Name == changelog.CategoryKnownIssues && ctx.opts.KnownIssuesStyle == KnownIssuesStyleCallout {
+			if len(cat.Entries) > 0 {
+				renderKnownIssuesCallout(sb, cat.Entries, ctx)
+			}
+			continue
+		}
+		fmt.Fprintf(sb, "\n### %s\n\n", categoryHeading(cat.Name, ctx))
+		if len(cat.Entries) == 0 {
+			fmt.Fprintf(sb, "*(%s)*\n", ctx.l.T("marker.none_this_release"))
+		}
+		for _, entry := range cat.Entries {
+			renderEntry(sb, &entry, ctx, cat.Name)
+		}
+	}
+}
+
+// releaseCategories returns the categories of r to render, in canonical
+// order filtered by tier, with entries sorted and grouped per the options.
+// Empty categories are included only with CompactEmptyCategories.
+func releaseCategories(r *changelog.Release, ctx renderContext) []changelog.Category {
 	maxTier := ctx.opts.MaxTier
 	if maxTier == "" {
 		maxTier = changelog.TierOptional
 	}
 
-	for _, cat := range r.CategoriesFiltered(maxTier) {
-		// Translate category name
-		categoryName := ctx.l.T(categoryToMessageID(cat.Name))
-		// Fall back to original name if translation is the message ID
-		if categoryName == categoryToMessageID(cat.Name) {
-			categoryName = cat.Name
+	cats := r.CategoriesFiltered(maxTier)
+	if ctx.opts.CompactEmptyCategories {
+		cats = nil
+		for _, name := range changelog.DefaultRegistry.NamesUpToTier(maxTier) {
+			cats = append(cats, changelog.Category{Name: name, Entries: r.GetEntries(name)})
 		}
-		fmt.Fprintf(sb, "\n### %s\n\n", categoryName)
-		for _, entry := range cat.Entries {
-			renderEntry(sb, &entry, ctx, cat.Name)
+	}
+
+	var result []changelog.Category
+	for _, cat := range cats {
+		if cat.Name == changelog.CategoryUpgradeGuide && !ctx.opts.IncludeUpgradeGuide {
+			continue
+		}
+		if cat.Name == changelog.CategoryKnownIssues && !ctx.opts.IncludeKnownIssues {
+			continue
+		}
+		cat.Entries = sortEntries(cat.Entries, ctx.opts.SortEntriesBy)
+		if ctx.opts.GroupByComponent {
+			cat.Entries = groupEntriesByComponent(cat.Entries)
+		}
+		result = append(result, cat)
+	}
+	return result
+}
+
+// sortEntries returns entries in the given sort order. The input slice is
+// never modified; it is returned as is for EntrySortNone.
+func sortEntries(entries []changelog.Entry, sort EntrySort) []changelog.Entry {
+	var compare func(a, b changelog.Entry) int
+	switch sort {
+	case EntrySortAlpha:
+		compare = func(a, b changelog.Entry) int {
+			return cmp.Compare(strings.ToLower(a.Description), strings.ToLower(b.Description))
+		}
+	case EntrySortBreakingFirst:
+		compare = func(a, b changelog.Entry) int {
+			switch {
+			case a.Breaking == b.Breaking:
+				return 0
+			case a.Breaking:
+				return -1
+			default:
+				return 1
+			}
+		}
+	default:
+		return entries
+	}
+	sorted := slices.Clone(entries)
+	slices.SortStableFunc(sorted, compare)
+	return sorted
+}
+
+// groupEntriesByComponent returns a copy of entries with those without a
+// component first, followed by the others grouped by Component in order of
+// each component's first appearance. Order within each group is preserved.
+func groupEntriesByComponent(entries []changelog.Entry) []changelog.Entry {
+	var components []string
+	byComponent := make(map[string][]changelog.Entry)
+	for _, e := range entries {
+		if _, ok := byComponent[e.Component]; !ok && e.Component != "" {
+			components = append(components, e.Component)
+		}
+		byComponent[e.Component] = append(byComponent[e.Component], e)
+	}
+
+	grouped := make([]changelog.Entry, 0, len(entries))
+	grouped = append(grouped, byComponent[""]...)
+	for _, c := range components {
+		grouped = append(grouped, byComponent[c]...)
+	}
+	return grouped
+}
+
+// categoryHeading returns the localized category name for a "###" header,
+// prefixed by its emoji when EmojiHeaders is enabled and followed by the
+// category's tier when ShowTierBadges is enabled.
+func categoryHeading(name string, ctx renderContext) string {
+	heading := localizedCategoryName(ctx.l, name)
+	if emoji := CategoryEmoji[name]; ctx.opts.EmojiHeaders && emoji != "" {
+		heading = emoji + " " + heading
+	}
+	if ctx.opts.ShowTierBadges {
+		if ct := changelog.DefaultRegistry.Get(name); ct != nil {
+			heading += " *(" + string(ct.Tier) + ")*"
 		}
 	}
+	return heading
+}
+
+// renderKnownIssuesCallout renders known issues as a blockquote warning box.
+func renderKnownIssuesCallout(sb *strings.Builder, entries []changelog.Entry, ctx renderContext) {
+	var body strings.Builder
+	for _, entry := range entries {
+		renderEntry(&body, &entry, ctx, changelog.CategoryKnownIssues)
+	}
+
+	fmt.Fprintf(sb, "\n> ⚠️ %s\n>\n", ctx.l.T("marker.known_issues_callout"))
+	for line := range strings.Lines(body.String()) {
+		sb.WriteString("> " + line)
+	}
+}
+
+// renderHighlightsCallout renders a release's highlights as a blockquote
+// placed before its header. Nothing is rendered if the release has no
+// highlights or MaxTier excludes them.
+func renderHighlightsCallout(sb *strings.Builder, r *changelog.Release, ctx renderContext) {
+	maxTier := ctx.opts.MaxTier
+	if maxTier == "" {
+		maxTier = changelog.TierOptional
+	}
+	ct := changelog.DefaultRegistry.Get(changelog.CategoryHighlights)
+	if len(r.Highlights) == 0 || ct == nil || !ct.Tier.IncludesOrHigher(maxTier) {
+		return
+	}
+
+	var body strings.Builder
+	for _, entry := range r.Highlights {
+		renderEntry(&body, &entry, ctx, changelog.CategoryHighlights)
+	}
+
+	fmt.Fprintf(sb, "> **%s**\n>\n", localizedCategoryName(ctx.l, changelog.CategoryHighlights))
+	for line := range strings.Lines(body.String()) {
+		sb.WriteString("> " + line)
+	}
+	sb.WriteString("\n")
 }
 
 func renderEntry(sb *strings.Builder, e *changelog.Entry, ctx renderContext, categoryName string) {
@@ -347,17 +666,41 @@ func renderEntry(sb *strings.Builder, e *changelog.Entry, ctx renderContext, cat
 	}
 
 	if e.Breaking && opts.MarkBreakingChanges {
-		desc = "**" + ctx.l.T("marker.breaking") + "** " + desc
+		desc = breakingPrefix(ctx) + " " + desc
+	}
+	if opts.GroupByComponent && e.Component != "" {
+		desc = "**" + e.Component + ":** " + desc
+	}
+	if opts.IncludeAffects && len(e.Affects) > 0 {
+		parts = append(parts, "("+strings.Join(e.Affects, ", ")+")")
 	}
 	parts = append(parts, desc)
+	if opts.IncludeDeprecationSince && e.Since != "" {
+		parts = append(parts, "("+ctx.l.Tf("marker.since", map[string]any{"Version": e.Since})+")")
+	}
+	if opts.IncludeRemovalDates && e.PlannedRemoval != "" {
+		parts = append(parts, "*("+ctx.l.Tf("marker.removal_planned", map[string]any{"Version": e.PlannedRemoval})+")*")
+	}
 
-	// References
+	// References. An external tracker URL replaces the repository link of
+	// the issue, or of the PR if there is no issue.
 	var refs []string
 	if e.Issue != "" && opts.IncludeReferences {
-		refs = append(refs, formatIssueRef(e.Issue, ctx))
+		if e.ExternalURL != "" {
+			refs = append(refs, formatExternalRef(e.Issue, e.ExternalURL, ctx))
+		} else {
+			refs = append(refs, formatIssueRef(e.Issue, ctx))
+		}
 	}
 	if e.PR != "" && opts.IncludeReferences {
-		refs = append(refs, formatPRRef(e.PR, ctx))
+		if e.ExternalURL != "" && e.Issue == "" {
+			refs = append(refs, formatExternalRef(e.PR, e.ExternalURL, ctx))
+		} else {
+			refs = append(refs, formatPRRef(e.PR, ctx))
+		}
+	}
+	if e.ExternalURL != "" && e.Issue == "" && e.PR == "" && opts.IncludeReferences {
+		refs = append(refs, formatExternalRef(e.ExternalURL, e.ExternalURL, ctx))
 	}
 	// Skip commit refs for Highlights - they're meant to be human-readable summaries
 	if e.Commit != "" && opts.IncludeReferences && opts.IncludeCommits && categoryName != changelog.CategoryHighlights {
@@ -388,7 +731,128 @@ func renderEntry(sb *strings.Builder, e *changelog.Entry, ctx renderContext, cat
 		line += " " + formatAuthorAttribution(e.Author, ctx)
 	}
 
-	sb.WriteString("- " + line + "\n")
+	// Notes as an indented paragraph under the list item
+	if opts.IncludeNotes && e.Notes != "" {
+		line += "\n\n" + strings.TrimSpace(e.Notes)
+	}
+
+	sb.WriteString(formatListItem(entryPrefix(ctx), line, opts.WrapWidth))
+}
+
+// formatListItem formats text as a Markdown list item. Lines after the first,
+// from embedded newlines or from wrapping at width columns, are indented by
+// two spaces so they continue the same item. Width zero disables wrapping.
+func formatListItem(prefix, text string, width int) string {
+	const indent = "  "
+
+	var sb strings.Builder
+	first := true
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if !first && line == "" {
+			sb.WriteString("\n")
+			continue
+		}
+		for _, wrapped := range wrapWords(line, width-len(indent)) {
+			if first {
+				sb.WriteString(prefix + wrapped + "\n")
+				first = false
+			} else {
+				sb.WriteString(indent + wrapped + "\n")
+			}
+		}
+	}
+	return sb.String()
+}
+
+// wrapWords splits text into lines of at most width runes, breaking at
+// spaces. Words longer than width are kept whole. Width zero or less returns
+// text as a single line.
+func wrapWords(text string, width int) []string {
+	words := strings.Fields(text)
+	if width <= 0 || len(words) == 0 {
+		return []string{text}
+	}
+
+	var lines []string
+	current := words[0]
+	for _, w := range words[1:] {
+		if utf8.RuneCountInString(current)+1+utf8.RuneCountInString(w) > width {
+			lines = append(lines, current)
+			current = w
+		} else {
+			current += " " + w
+		}
+	}
+	return append(lines, current)
+}
+
+// versionBump returns the semver bump type implied by a release's entries,
+// following gitlog.ParseResult.SuggestReleaseType: "major" if there are
+// breaking changes, "minor" if there are Added entries, and "patch"
+// otherwise.
+func versionBump(r *changelog.Release) string {
+	if len(r.BreakingEntries()) > 0 {
+		return changelog.ReleaseTypeMajor
+	}
+	if len(r.Added) > 0 {
+		return changelog.ReleaseTypeMinor
+	}
+	return changelog.ReleaseTypePatch
+}
+
+// dateSuffix returns the " - date" suffix for a release header, or an empty
+// string when IncludeDates is disabled.
+func dateSuffix(date string, ctx renderContext) string {
+	if !ctx.opts.IncludeDates {
+		return ""
+	}
+	return " - " + formatDate(date, ctx)
+}
+
+// formatDate re-formats a YYYY-MM-DD release date using the DateFormat
+// layout. Dates that fail to parse are returned unchanged.
+func formatDate(date string, ctx renderContext) string {
+	layout := ctx.opts.DateFormat
+	if layout == "" || layout == DefaultDateFormat {
+		return date
+	}
+	t, err := time.Parse(DefaultDateFormat, date)
+	if err != nil {
+		return date
+	}
+	return t.Format(layout)
+}
+
+// versionHeading formats a version (or the Unreleased label) for a release
+// header according to VersionLinkStyle. The linked style falls back to
+// brackets, resolved by the bottom reference links, when compareURL is empty.
+func versionHeading(version, compareURL string, ctx renderContext) string {
+	switch ctx.opts.VersionLinkStyle {
+	case VersionLinkStylePlain:
+		return version
+	case VersionLinkStyleLinked:
+		if compareURL != "" {
+			return "[" + version + "](" + compareURL + ")"
+		}
+	}
+	return "[" + version + "]"
+}
+
+// entryPrefix returns the list marker written before each entry.
+func entryPrefix(ctx renderContext) string {
+	if ctx.opts.EntryPrefix != "" {
+		return ctx.opts.EntryPrefix
+	}
+	return "- "
+}
+
+// breakingPrefix returns the marker prepended to breaking change entries.
+func breakingPrefix(ctx renderContext) string {
+	if ctx.opts.CustomBreakingPrefix != "" {
+		return ctx.opts.CustomBreakingPrefix
+	}
+	return "**" + ctx.l.T("marker.breaking") + "**"
 }
 
 // formatAuthorAttribution formats an author attribution with a GitHub link.
@@ -398,17 +862,22 @@ func formatAuthorAttribution(author string, ctx renderContext) string {
 	if len(name) > 0 && name[0] == '@' {
 		name = name[1:]
 	}
+	return "by " + authorProfileLink(name, ctx)
+}
 
+// authorProfileLink formats "@name" linked to the author's profile on the
+// repository host, or unlinked if the host is unknown.
+func authorProfileLink(name string, ctx renderContext) string {
 	// Create linked attribution if we can determine the host
-	if ctx.host == hostGitHub {
-		return fmt.Sprintf("by [@%s](https://github.com/%s)", name, name)
+	if ctx.repo.Host == changelog.RepoHostGitHub {
+		return fmt.Sprintf("[@%s](https://github.com/%s)", name, name)
 	}
-	if ctx.host == hostGitLab {
-		return fmt.Sprintf("by [@%s](https://gitlab.com/%s)", name, name)
+	if ctx.repo.Host == changelog.RepoHostGitLab {
+		return fmt.Sprintf("[@%s](https://gitlab.com/%s)", name, name)
 	}
 
 	// Fallback: just show the author name with @ prefix
-	return fmt.Sprintf("by @%s", name)
+	return "@" + name
 }
 
 // stripInlineAttribution removes inline attribution patterns from a description
@@ -463,8 +932,8 @@ func formatIssueRef(value string, ctx renderContext) string {
 	num := strings.TrimPrefix(value, "#")
 
 	// If linking enabled and we have a repository
-	if ctx.opts.LinkReferences && ctx.baseURL != "" && ctx.host != hostUnknown {
-		url := formatIssueURL(ctx.baseURL, ctx.host, num)
+	if ctx.opts.LinkReferences && ctx.repo.Known() {
+		url := ctx.repo.IssueURL(num)
 		return fmt.Sprintf("[#%s](%s)", num, url)
 	}
 
@@ -482,31 +951,54 @@ func formatPRRef(value string, ctx renderContext) string {
 	num := strings.TrimPrefix(value, "#")
 
 	// If linking enabled and we have a repository
-	if ctx.opts.LinkReferences && ctx.baseURL != "" && ctx.host != hostUnknown {
-		url := formatPRURL(ctx.baseURL, ctx.host, num)
+	if ctx.opts.LinkReferences && ctx.repo.Known() {
+		url := ctx.repo.PRURL(num)
 		return fmt.Sprintf("[#%s](%s)", num, url)
 	}
 
 	return fmt.Sprintf("#%s", num)
 }
 
+// formatExternalRef formats an issue or PR reference linked to an external
+// tracker URL. Numeric references are shown as "#123"; others, such as JIRA
+// keys, are shown as given.
+func formatExternalRef(value, url string, ctx renderContext) string {
+	label := value
+	if num := strings.TrimPrefix(value, "#"); num != "" && strings.Trim(num, "0123456789") == "" {
+		label = "#" + num
+	}
+	if ctx.opts.LinkReferences {
+		return fmt.Sprintf("[%s](%s)", label, url)
+	}
+	return label
+}
+
 // formatCommitRef formats a commit reference, optionally with a link.
 func formatCommitRef(value string, ctx renderContext) string {
-	// Display short hash (first 7 chars) if longer
+	// Display short hash if longer
 	shortHash := value
-	if len(value) > 7 {
-		shortHash = value[:7]
+	if n := commitHashLength(ctx.opts); len(value) > n {
+		shortHash = value[:n]
 	}
 
 	// If linking enabled and we have a repository
-	if ctx.opts.LinkReferences && ctx.baseURL != "" && ctx.host != hostUnknown {
-		url := formatCommitURL(ctx.baseURL, ctx.host, value)
+	if ctx.opts.LinkReferences && ctx.repo.Known() {
+		url := ctx.repo.CommitURL(value)
 		return fmt.Sprintf("[`%s`](%s)", shortHash, url)
 	}
 
 	return shortHash
 }
 
+// commitHashLength returns opts.CommitHashLength clamped to
+// MinCommitHashLength-MaxCommitHashLength, or DefaultCommitHashLength if unset.
+func commitHashLength(opts Options) int {
+	if opts.CommitHashLength == 0 {
+		return DefaultCommitHashLength
+	}
+	return min(max(opts.CommitHashLength, MinCommitHashLength), MaxCommitHashLength)
+}
+
 // extractNumber extracts the trailing number from a URL like /issues/123
 func extractNumber(url string) string {
 	parts := strings.Split(url, "/")
@@ -516,56 +1008,6 @@ func extractNumber(url string) string {
 	return url
 }
 
-// formatIssueURL generates an issue URL for the given host.
-func formatIssueURL(baseURL string, host repoHost, num string) string {
-	switch host {
-	case hostGitLab:
-		return fmt.Sprintf("%s/-/issues/%s", baseURL, num)
-	default: // hostGitHub
-		return fmt.Sprintf("%s/issues/%s", baseURL, num)
-	}
-}
-
-// formatPRURL generates a PR/MR URL for the given host.
-func formatPRURL(baseURL string, host repoHost, num string) string {
-	switch host {
-	case hostGitLab:
-		return fmt.Sprintf("%s/-/merge_requests/%s", baseURL, num)
-	default: // hostGitHub
-		return fmt.Sprintf("%s/pull/%s", baseURL, num)
-	}
-}
-
-// formatCommitURL generates a commit URL for the given host.
-func formatCommitURL(baseURL string, host repoHost, sha string) string {
-	switch host {
-	case hostGitLab:
-		return fmt.Sprintf("%s/-/commit/%s", baseURL, sha)
-	default: // hostGitHub
-		return fmt.Sprintf("%s/commit/%s", baseURL, sha)
-	}
-}
-
-// repoHost represents a supported repository hosting platform.
-type repoHost int
-
-const (
-	hostUnknown repoHost = iota
-	hostGitHub
-	hostGitLab
-)
-
-// parseRepository extracts the base URL and host type from a repository URL.
-func parseRepository(repoURL string) (baseURL string, host repoHost) {
-	if matches := githubRepoPattern.FindStringSubmatch(repoURL); matches != nil {
-		return fmt.Sprintf("https://github.com/%s/%s", matches[1], matches[2]), hostGitHub
-	}
-	if matches := gitlabRepoPattern.FindStringSubmatch(repoURL); matches != nil {
-		return fmt.Sprintf("https://gitlab.com/%s", matches[1]), hostGitLab
-	}
-	return "", hostUnknown
-}
-
 // renderReferenceLinks generates Keep a Changelog style reference links.
 // For GitHub repositories, it creates:
 // - Compare links for subsequent releases: /compare/v0.1.0...v0.2.0
@@ -576,15 +1018,18 @@ func parseRepository(repoURL string) (baseURL string, host repoHost) {
 // - Tag links for the first release: /-/tags/v0.1.0
 // - Compare to HEAD for unreleased: /-/compare/v0.2.0...HEAD
 // If TagPath is set (e.g., "sdk/go"), tags are prefixed: sdk/go/v0.1.0
-func renderReferenceLinks(cl *changelog.Changelog, includeUnreleasedLink bool) string {
-	return renderReferenceLinksForReleases(cl, cl.Releases, includeUnreleasedLink)
+// Versions in labels and tags are adjusted by versionPrefix; see changelog.PrefixVersion.
+func renderReferenceLinks(cl *changelog.Changelog, includeUnreleasedLink bool, versionPrefix string) string {
+	return renderReferenceLinksForReleases(cl, cl.Releases, includeUnreleasedLink, 0, versionPrefix)
 }
 
 // renderReferenceLinksForReleases generates reference links for a specific set of releases.
-// This variant is used when filtering releases (e.g., notable-only mode).
-func renderReferenceLinksForReleases(cl *changelog.Changelog, releases []changelog.Release, includeUnreleasedLink bool) string {
-	baseURL, host := parseRepository(cl.Repository)
-	if host == hostUnknown {
+// This variant is used when filtering releases (e.g., notable-only mode). If limit
+// is positive, links are only generated for the first limit releases, which still
+// compare against the next older release in the set.
+func renderReferenceLinksForReleases(cl *changelog.Changelog, releases []changelog.Release, includeUnreleasedLink bool, limit int, versionPrefix string) string {
+	repo := changelog.ParseRepoURL(cl.Repository)
+	if !repo.Known() {
 		return ""
 	}
 
@@ -593,61 +1038,25 @@ func renderReferenceLinksForReleases(cl *changelog.Changelog, releases []changel
 	// Unreleased link (always included by default when there are releases)
 	// This lets users see what's been merged since the last release
 	if includeUnreleasedLink && len(releases) > 0 {
-		latestVersion := releases[0].Version
-		fmt.Fprintf(&sb, "[unreleased]: %s\n", formatCompareLink(baseURL, host, cl.TagPath, latestVersion, "HEAD"))
+		latestVersion := changelog.PrefixVersion(releases[0].Version, versionPrefix)
+		fmt.Fprintf(&sb, "[unreleased]: %s\n", repo.CompareURL(cl.TagPath, latestVersion, "HEAD"))
 	}
 
 	// Release links
 	for i, release := range releases {
+		if limit > 0 && i >= limit {
+			break
+		}
+		version := changelog.PrefixVersion(release.Version, versionPrefix)
 		if i == len(releases)-1 {
 			// First/oldest release - link to tag
-			fmt.Fprintf(&sb, "[%s]: %s\n", release.Version, formatTagLink(baseURL, host, cl.TagPath, release.Version))
+			fmt.Fprintf(&sb, "[%s]: %s\n", version, repo.TagURL(cl.TagPath, version))
 		} else {
 			// Subsequent releases - link to compare with previous
-			prevVersion := releases[i+1].Version
-			fmt.Fprintf(&sb, "[%s]: %s\n", release.Version, formatCompareLink(baseURL, host, cl.TagPath, prevVersion, release.Version))
+			prevVersion := changelog.PrefixVersion(releases[i+1].Version, versionPrefix)
+			fmt.Fprintf(&sb, "[%s]: %s\n", version, repo.CompareURL(cl.TagPath, prevVersion, version))
 		}
 	}
 
 	return sb.String()
 }
-
-// formatCompareLink generates a comparison URL for the given host.
-// Versions are used as-is (no automatic v prefix added).
-// If tagPath is non-empty, it's prepended to version tags (e.g., "sdk/go" + "v1.0.0" = "sdk/go/v1.0.0").
-func formatCompareLink(baseURL string, host repoHost, tagPath, fromVersion, toVersion string) string {
-	fromTag := formatVersionTag(tagPath, fromVersion)
-	toTag := formatVersionTag(tagPath, toVersion)
-	switch host {
-	case hostGitLab:
-		return fmt.Sprintf("%s/-/compare/%s...%s", baseURL, fromTag, toTag)
-	default: // hostGitHub
-		return fmt.Sprintf("%s/compare/%s...%s", baseURL, fromTag, toTag)
-	}
-}
-
-// formatTagLink generates a tag URL for the given host.
-// Versions are used as-is (no automatic v prefix added).
-// If tagPath is non-empty, it's prepended to version tags (e.g., "sdk/go" + "v1.0.0" = "sdk/go/v1.0.0").
-func formatTagLink(baseURL string, host repoHost, tagPath, version string) string {
-	tag := formatVersionTag(tagPath, version)
-	switch host {
-	case hostGitLab:
-		return fmt.Sprintf("%s/-/releases/%s", baseURL, tag)
-	default: // hostGitHub
-		return fmt.Sprintf("%s/releases/tag/%s", baseURL, tag)
-	}
-}
-
-// formatVersionTag formats a version with an optional tag path prefix.
-// For example, with tagPath="sdk/go" and version="v1.0.0", returns "sdk/go/v1.0.0".
-// If tagPath is empty, returns the version unchanged.
-// Special case: "HEAD" is never prefixed as it's a git ref, not a version tag.
-func formatVersionTag(tagPath, version string) string {
-	if tagPath == "" || version == "HEAD" {
-		return version
-	}
-	// Ensure no double slashes
-	tagPath = strings.TrimSuffix(tagPath, "/")
-	return tagPath + "/" + version
-}
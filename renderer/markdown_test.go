@@ -1,6 +1,8 @@
 package renderer
 
 import (
+	"errors"
+	"slices"
 	"strings"
 	"testing"
 
@@ -112,6 +114,69 @@ func TestRenderMarkdown_BreakingChange(t *testing.T) {
 	}
 }
 
+func TestRenderMarkdown_CustomBreakingPrefix(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version: "2.0.0",
+				Date:    "2026-01-03",
+				Changed: []changelog.Entry{{Description: "API change", Breaking: true}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		opts     Options
+		expected string
+	}{
+		{"default prefix", DefaultOptions(), "- **BREAKING:** API change"},
+		{"custom prefix", DefaultOptions().WithBreakingPrefix("⚠️ BREAKING:"), "- ⚠️ BREAKING: API change"},
+		{"bracket prefix", FullOptions().WithBreakingPrefix("[BREAKING]"), "- [BREAKING] API change"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := RenderMarkdownWithOptions(cl, tt.opts)
+			if !strings.Contains(md, tt.expected) {
+				t.Errorf("expected %q in output, got:\n%s", tt.expected, md)
+			}
+		})
+	}
+
+	// Custom prefix is ignored when MarkBreakingChanges is false
+	opts := MinimalOptions().WithBreakingPrefix("[BREAKING]")
+	if md := RenderMarkdownWithOptions(cl, opts); strings.Contains(md, "[BREAKING]") {
+		t.Error("custom prefix should not be rendered when MarkBreakingChanges is false")
+	}
+}
+
+func TestRenderMarkdown_Affects(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version: "1.1.0",
+				Date:    "2026-01-03",
+				Added:   []changelog.Entry{{Description: "Token refresh", Affects: []string{"api", "sdk"}}},
+			},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, MinimalOptions())
+	if strings.Contains(md, "(api, sdk)") {
+		t.Error("affects should not be rendered when IncludeAffects is false")
+	}
+
+	md = RenderMarkdownWithOptions(cl, MinimalOptions().WithIncludeAffects(true))
+	if !strings.Contains(md, "- (api, sdk) Token refresh") {
+		t.Errorf("expected affects prefix, got:\n%s", md)
+	}
+}
+
 func TestRenderMarkdown_SecurityMetadata(t *testing.T) {
 	cl := &changelog.Changelog{
 		IRVersion: "1.0",
@@ -890,8 +955,8 @@ func TestRenderMarkdown_LinkedReferences_GitHub(t *testing.T) {
 	if !strings.Contains(md, "[#43](https://github.com/example/repo/pull/43)") {
 		t.Error("missing linked PR reference")
 	}
-	// Check commit link (short hash with backticks)
-	if !strings.Contains(md, "[`abc123d`](https://github.com/example/repo/commit/abc123def456789)") {
+	// Check commit link (12-char short hash with backticks)
+	if !strings.Contains(md, "[`abc123def456`](https://github.com/example/repo/commit/abc123def456789)") {
 		t.Error("missing linked commit reference")
 	}
 }
@@ -923,7 +988,7 @@ func TestRenderMarkdown_LinkedReferences_GitLab(t *testing.T) {
 		t.Error("missing linked MR reference for GitLab")
 	}
 	// Check commit link (GitLab style)
-	if !strings.Contains(md, "[`abc123d`](https://gitlab.com/example/repo/-/commit/abc123def456789)") {
+	if !strings.Contains(md, "[`abc123def456`](https://gitlab.com/example/repo/-/commit/abc123def456789)") {
 		t.Error("missing linked commit reference for GitLab")
 	}
 }
@@ -1711,6 +1776,61 @@ func TestRenderMarkdown_ReferenceLinks_WithTagPath(t *testing.T) {
 	}
 }
 
+func TestRenderMarkdown_CommitLink_WithTagPath(t *testing.T) {
+	tests := []struct {
+		name        string
+		repository  string
+		wantCommit  string
+		wantCompare string
+	}{
+		{
+			name:        "github",
+			repository:  "https://github.com/agentplexus/multi-agent-spec",
+			wantCommit:  "[`abc1234`](https://github.com/agentplexus/multi-agent-spec/commit/abc1234def5678)",
+			wantCompare: "[v0.2.0]: https://github.com/agentplexus/multi-agent-spec/compare/sdk/go/v0.1.0...sdk/go/v0.2.0",
+		},
+		{
+			name:        "gitlab",
+			repository:  "https://gitlab.com/agentplexus/multi-agent-spec",
+			wantCommit:  "[`abc1234`](https://gitlab.com/agentplexus/multi-agent-spec/-/commit/abc1234def5678)",
+			wantCompare: "[v0.2.0]: https://gitlab.com/agentplexus/multi-agent-spec/-/compare/sdk/go/v0.1.0...sdk/go/v0.2.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := &changelog.Changelog{
+				IRVersion:  "1.0",
+				Project:    "multi-agent-spec/sdk/go",
+				Repository: tt.repository,
+				TagPath:    "sdk/go",
+				Releases: []changelog.Release{
+					{
+						Version: "v0.2.0",
+						Date:    "2026-01-16",
+						Commit:  "abc1234def5678",
+						Added:   []changelog.Entry{{Description: "New", Commit: "abc1234def5678"}},
+					},
+					{Version: "v0.1.0", Date: "2026-01-15", Added: []changelog.Entry{{Description: "Initial"}}},
+				},
+			}
+
+			md := RenderMarkdown(cl)
+
+			// Commit links are bare repository URLs; only version links use TagPath
+			if got := strings.Count(md, tt.wantCommit); got != 2 {
+				t.Errorf("expected release and entry commit links %q, found %d in:\n%s", tt.wantCommit, got, md)
+			}
+			if strings.Contains(md, "/commit/sdk/go") || strings.Contains(md, "/sdk/go/commit") {
+				t.Errorf("commit links must not include the tag path:\n%s", md)
+			}
+			if !strings.Contains(md, tt.wantCompare) {
+				t.Errorf("expected compare link %q in:\n%s", tt.wantCompare, md)
+			}
+		})
+	}
+}
+
 func TestRenderMarkdown_ReferenceLinks_WithTagPath_Unreleased(t *testing.T) {
 	cl := &changelog.Changelog{
 		IRVersion:  "1.0",
@@ -2053,54 +2173,949 @@ func TestRenderMarkdown_NotableOnly_WithUnreleased(t *testing.T) {
 	}
 }
 
-func TestFilterNotableReleases(t *testing.T) {
-	releases := []changelog.Release{
-		{Version: "1.0.3", Added: []changelog.Entry{{Description: "Feature"}}},
-		{Version: "1.0.2", Dependencies: []changelog.Entry{{Description: "Deps"}}},
-		{Version: "1.0.1", Fixed: []changelog.Entry{{Description: "Fix"}}},
-		{Version: "1.0.0", Documentation: []changelog.Entry{{Description: "Docs"}}},
+func TestRenderMarkdownSection(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion:  "1.0",
+		Project:    "test",
+		Repository: "https://github.com/example/repo",
+		Unreleased: &changelog.Release{
+			Added: []changelog.Entry{{Description: "WIP feature"}},
+		},
+		Releases: []changelog.Release{
+			{
+				Version: "1.1.0",
+				Date:    "2024-02-01",
+				Added:   []changelog.Entry{{Description: "New API", PR: "12"}},
+				Fixed:   []changelog.Entry{{Description: "Crash on start"}},
+			},
+			{Version: "1.0.0", Date: "2024-01-01", Added: []changelog.Entry{{Description: "Initial"}}},
+		},
 	}
 
-	// Default policy
-	filtered := filterNotableReleases(releases, changelog.DefaultNotabilityPolicy())
+	out, err := RenderMarkdownSection(cl, "1.1.0", DefaultOptions())
+	if err != nil {
+		t.Fatalf("RenderMarkdownSection failed: %v", err)
+	}
+	if !strings.HasPrefix(out, "## [1.1.0] - 2024-02-01\n") {
+		t.Errorf("expected section header first, got:\n%s", out)
+	}
+	for _, want := range []string{"### Added\n", "- New API ([#12](https://github.com/example/repo/pull/12))\n", "### Fixed\n"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	for _, unwanted := range []string{"# Changelog", "[1.1.0]: ", "Initial", "WIP feature"} {
+		if strings.Contains(out, unwanted) {
+			t.Errorf("did not expect %q, got:\n%s", unwanted, out)
+		}
+	}
 
-	if len(filtered) != 2 {
-		t.Errorf("expected 2 notable releases, got %d", len(filtered))
+	out, err = RenderMarkdownSection(cl, "unreleased", DefaultOptions())
+	if err != nil {
+		t.Fatalf("RenderMarkdownSection(unreleased) failed: %v", err)
 	}
+	if !strings.HasPrefix(out, "## [Unreleased]\n") || !strings.Contains(out, "- WIP feature\n") {
+		t.Errorf("unexpected unreleased section:\n%s", out)
+	}
+}
 
-	// Check correct releases are included
-	versions := make(map[string]bool)
-	for _, r := range filtered {
-		versions[r.Version] = true
+func TestRenderMarkdownSection_VersionNotFound(t *testing.T) {
+	cl := &changelog.Changelog{IRVersion: "1.0", Project: "test"}
+
+	for _, version := range []string{"9.9.9", "unreleased"} {
+		if _, err := RenderMarkdownSection(cl, version, DefaultOptions()); !errors.Is(err, changelog.ErrVersionNotFound) {
+			t.Errorf("RenderMarkdownSection(%q) error = %v, expected ErrVersionNotFound", version, err)
+		}
 	}
+}
 
-	if !versions["1.0.3"] {
-		t.Error("expected 1.0.3 to be included (has Added)")
+func TestRenderMarkdownEntry(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion:  "1.0",
+		Project:    "test",
+		Repository: "https://github.com/example/repo",
+		Unreleased: &changelog.Release{
+			Added: []changelog.Entry{{Description: "WIP feature"}},
+		},
+		Releases: []changelog.Release{
+			{
+				Version: "1.1.0",
+				Date:    "2024-02-01",
+				Added:   []changelog.Entry{{Description: "New API", PR: "12"}},
+				Fixed:   []changelog.Entry{{Description: "Crash on start"}},
+			},
+		},
 	}
-	if !versions["1.0.1"] {
-		t.Error("expected 1.0.1 to be included (has Fixed)")
+
+	out, err := RenderMarkdownEntry(cl, "1.1.0", DefaultOptions())
+	if err != nil {
+		t.Fatalf("RenderMarkdownEntry failed: %v", err)
 	}
-	if versions["1.0.2"] {
-		t.Error("expected 1.0.2 to be excluded (Dependencies only)")
+	want := "**Added:**\n\n- New API ([#12](https://github.com/example/repo/pull/12))\n\n**Fixed:**\n\n- Crash on start\n"
+	if out != want {
+		t.Errorf("RenderMarkdownEntry() =\n%s\nwant:\n%s", out, want)
 	}
-	if versions["1.0.0"] {
-		t.Error("expected 1.0.0 to be excluded (Documentation only)")
+
+	out, err = RenderMarkdownEntry(cl, "unreleased", DefaultOptions())
+	if err != nil || out != "**Added:**\n\n- WIP feature\n" {
+		t.Errorf("unexpected unreleased entry %q, %v", out, err)
+	}
+
+	if _, err := RenderMarkdownEntry(cl, "9.9.9", DefaultOptions()); !errors.Is(err, changelog.ErrVersionNotFound) {
+		t.Errorf("expected ErrVersionNotFound, got %v", err)
 	}
 }
 
-func TestFilterNotableReleases_NilPolicy(t *testing.T) {
-	releases := []changelog.Release{
-		{Version: "1.0.1", Dependencies: []changelog.Entry{{Description: "Deps"}}},
-		{Version: "1.0.0", Added: []changelog.Entry{{Description: "Feature"}}},
+func TestRenderMarkdown_EntryPrefix(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{Version: "1.0.0", Date: "2024-01-01", Added: []changelog.Entry{{Description: "Feature"}}},
+		},
 	}
 
-	// Nil policy should use default
-	filtered := filterNotableReleases(releases, nil)
+	for _, prefix := range ValidEntryPrefixes {
+		opts, err := DefaultOptions().WithEntryPrefix(prefix)
+		if err != nil {
+			t.Fatalf("WithEntryPrefix(%q) failed: %v", prefix, err)
+		}
+		md := RenderMarkdownWithOptions(cl, opts)
+		if !strings.Contains(md, "\n"+prefix+"Feature\n") {
+			t.Errorf("expected entry with prefix %q, got:\n%s", prefix, md)
+		}
+	}
 
-	if len(filtered) != 1 {
-		t.Errorf("expected 1 notable release with nil policy, got %d", len(filtered))
+	// Zero-value options fall back to "- "
+	if md := RenderMarkdownWithOptions(cl, Options{}); !strings.Contains(md, "\n- Feature\n") {
+		t.Errorf("expected default '- ' prefix, got:\n%s", md)
 	}
-	if filtered[0].Version != "1.0.0" {
-		t.Errorf("expected version 1.0.0, got %s", filtered[0].Version)
+}
+
+func TestRenderMarkdown_VersionLinkStyle(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion:  "1.0",
+		Project:    "test",
+		Repository: "https://github.com/example/repo",
+		Releases: []changelog.Release{
+			{
+				Version:    "1.1.0",
+				Date:       "2024-02-01",
+				CompareURL: "https://example.com/compare/1.0.0...1.1.0",
+				Added:      []changelog.Entry{{Description: "Feature"}},
+			},
+			{Version: "1.0.0", Date: "2024-01-01", Added: []changelog.Entry{{Description: "Initial"}}},
+		},
+	}
+
+	tests := []struct {
+		style      VersionLinkStyle
+		want       []string
+		wantNoRefs bool
+	}{
+		{
+			style: VersionLinkStyleBracketed,
+			want:  []string{"## [1.1.0] - 2024-02-01\n", "## [1.0.0] - 2024-01-01\n", "[1.1.0]: https://github.com/"},
+		},
+		{
+			style:      VersionLinkStylePlain,
+			want:       []string{"## 1.1.0 - 2024-02-01\n", "## 1.0.0 - 2024-01-01\n", "## Unreleased\n"},
+			wantNoRefs: true,
+		},
+		{
+			// 1.0.0 has no CompareURL, so it keeps brackets resolved by the reference links
+			style: VersionLinkStyleLinked,
+			want:  []string{"## [1.1.0](https://example.com/compare/1.0.0...1.1.0) - 2024-02-01\n", "## [1.0.0] - 2024-01-01\n", "[1.0.0]: https://github.com/"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.style), func(t *testing.T) {
+			opts, err := FullOptions().WithVersionLinkStyle(tt.style)
+			if err != nil {
+				t.Fatalf("WithVersionLinkStyle failed: %v", err)
+			}
+			md := RenderMarkdownWithOptions(cl, opts)
+			for _, want := range tt.want {
+				if !strings.Contains(md, want) {
+					t.Errorf("expected %q in output:\n%s", want, md)
+				}
+			}
+			if tt.wantNoRefs && strings.Contains(md, "]: https://") {
+				t.Errorf("expected no reference links, got:\n%s", md)
+			}
+		})
+	}
+}
+
+func TestRenderMarkdown_MultiLineDescription(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version: "2.0.0",
+				Date:    "2024-01-01",
+				UpgradeGuide: []changelog.Entry{
+					{Description: "Rename the config file:\nmv app.yaml config.yaml", PR: "42"},
+				},
+			},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, DefaultOptions())
+	want := "- Rename the config file:\n  mv app.yaml config.yaml (#42)\n"
+	if !strings.Contains(md, want) {
+		t.Errorf("expected continuation line, got:\n%s", md)
+	}
+}
+
+func TestRenderMarkdown_WrapWidth(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version: "1.0.0",
+				Date:    "2024-01-01",
+				Added:   []changelog.Entry{{Description: "Add a configurable retry policy for outbound webhook deliveries"}},
+			},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, MinimalOptions().WithWrapWidth(30))
+	want := "- Add a configurable retry\n  policy for outbound webhook\n  deliveries\n"
+	if !strings.Contains(md, want) {
+		t.Errorf("expected wrapped entry, got:\n%s", md)
+	}
+
+	md = RenderMarkdownWithOptions(cl, MinimalOptions())
+	if !strings.Contains(md, "- Add a configurable retry policy for outbound webhook deliveries\n") {
+		t.Errorf("expected no wrapping by default, got:\n%s", md)
+	}
+}
+
+func TestWrapWords(t *testing.T) {
+	tests := []struct {
+		text  string
+		width int
+		want  []string
+	}{
+		{"one two three", 0, []string{"one two three"}},
+		{"one two three", 7, []string{"one two", "three"}},
+		{"one two three", 3, []string{"one", "two", "three"}},
+		{"supercalifragilistic word", 5, []string{"supercalifragilistic", "word"}},
+		{"", 10, []string{""}},
+	}
+
+	for _, tt := range tests {
+		if got := wrapWords(tt.text, tt.width); !slices.Equal(got, tt.want) {
+			t.Errorf("wrapWords(%q, %d) = %q, expected %q", tt.text, tt.width, got, tt.want)
+		}
+	}
+}
+
+func TestRenderMarkdown_DateFormat(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{Version: "1.1.0", Date: "2024-01-15", Added: []changelog.Entry{{Description: "Feature"}}},
+			{Version: "1.0.0", Date: "Q4 2023", Added: []changelog.Entry{{Description: "Initial"}}},
+		},
+	}
+
+	tests := []struct {
+		layout string
+		want   string
+	}{
+		{"", "## [1.1.0] - 2024-01-15\n"},
+		{DefaultDateFormat, "## [1.1.0] - 2024-01-15\n"},
+		{"January 2, 2006", "## [1.1.0] - January 15, 2024\n"},
+		{"02/01/2006", "## [1.1.0] - 15/01/2024\n"},
+	}
+
+	for _, tt := range tests {
+		md := RenderMarkdownWithOptions(cl, FullOptions().WithDateFormat(tt.layout))
+		if !strings.Contains(md, tt.want) {
+			t.Errorf("DateFormat %q: expected %q in output:\n%s", tt.layout, tt.want, md)
+		}
+		// Unparseable dates fall back to the raw string
+		if !strings.Contains(md, "## [1.0.0] - Q4 2023\n") {
+			t.Errorf("DateFormat %q: expected raw date fallback, got:\n%s", tt.layout, md)
+		}
+	}
+}
+
+func TestRenderMarkdown_IncludeDates(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion:  "1.0",
+		Project:    "test",
+		Repository: "https://github.com/example/repo",
+		Releases: []changelog.Release{
+			{Version: "1.1.0", Date: "2024-01-15", Added: []changelog.Entry{{Description: "Feature"}}},
+			{Version: "1.0.0", Date: "2024-01-01", Added: []changelog.Entry{{Description: "Initial"}}},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, FullOptions())
+	if !strings.Contains(md, "## [1.1.0] - 2024-01-15\n") {
+		t.Errorf("expected dated header, got:\n%s", md)
+	}
+
+	md = RenderMarkdownWithOptions(cl, FullOptions().WithIncludeDates(false))
+	if !strings.Contains(md, "## [1.1.0]\n") || !strings.Contains(md, "## [1.0.0]\n") {
+		t.Errorf("expected undated headers, got:\n%s", md)
+	}
+	if strings.Contains(md, "2024-01") {
+		t.Errorf("expected no dates in output, got:\n%s", md)
+	}
+	if !strings.Contains(md, "[1.1.0]: https://github.com/example/repo/compare/1.0.0...1.1.0") {
+		t.Errorf("expected compare links to be kept, got:\n%s", md)
+	}
+}
+
+func TestRenderMarkdown_KnownIssuesStyle(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version:     "1.0.0",
+				Date:        "2024-01-01",
+				Added:       []changelog.Entry{{Description: "Feature"}},
+				KnownIssues: []changelog.Entry{{Description: "Export hangs on large files"}, {Description: "Dark mode flickers"}},
+			},
+		},
+	}
+
+	// Inline (default)
+	md := RenderMarkdownWithOptions(cl, DefaultOptions())
+	if !strings.Contains(md, "### Known Issues\n\n- Export hangs on large files\n- Dark mode flickers\n") {
+		t.Errorf("expected inline known issues section, got:\n%s", md)
+	}
+
+	// Callout
+	opts, err := DefaultOptions().WithKnownIssuesStyle(KnownIssuesStyleCallout)
+	if err != nil {
+		t.Fatalf("WithKnownIssuesStyle failed: %v", err)
+	}
+	md = RenderMarkdownWithOptions(cl, opts)
+	want := "> ⚠️ Known Issues in this release:\n>\n> - Export hangs on large files\n> - Dark mode flickers\n"
+	if !strings.Contains(md, want) {
+		t.Errorf("expected known issues callout, got:\n%s", md)
+	}
+	if strings.Contains(md, "### Known Issues") {
+		t.Error("callout style should not render a Known Issues heading")
+	}
+
+	// Disabled
+	md = RenderMarkdownWithOptions(cl, DefaultOptions().WithIncludeKnownIssues(false))
+	if strings.Contains(md, "Known Issues") || strings.Contains(md, "Export hangs") {
+		t.Errorf("expected known issues to be omitted, got:\n%s", md)
+	}
+	if !strings.Contains(md, "- Feature") {
+		t.Error("expected other categories to be rendered")
+	}
+}
+
+func TestRenderMarkdown_IncludeDeprecationSince(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version:    "1.3.0",
+				Date:       "2024-03-01",
+				Deprecated: []changelog.Entry{{Description: "Legacy auth endpoint", Since: "v1.2.0"}},
+			},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, DefaultOptions())
+	if strings.Contains(md, "since v1.2.0") {
+		t.Errorf("did not expect since marker by default, got:\n%s", md)
+	}
+
+	md = RenderMarkdownWithOptions(cl, DefaultOptions().WithIncludeDeprecationSince(true))
+	if !strings.Contains(md, "- Legacy auth endpoint (since v1.2.0)\n") {
+		t.Errorf("expected since marker, got:\n%s", md)
+	}
+}
+
+func TestRenderMarkdown_IncludeRemovalDates(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version:    "1.3.0",
+				Date:       "2024-03-01",
+				Deprecated: []changelog.Entry{{Description: "Legacy auth endpoint", PlannedRemoval: "v2.0.0"}},
+			},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, DefaultOptions())
+	if strings.Contains(md, "removal planned") {
+		t.Errorf("did not expect removal marker by default, got:\n%s", md)
+	}
+
+	md = RenderMarkdownWithOptions(cl, DefaultOptions().WithIncludeRemovalDates(true))
+	if !strings.Contains(md, "- Legacy auth endpoint *(removal planned: v2.0.0)*\n") {
+		t.Errorf("expected removal marker, got:\n%s", md)
+	}
+}
+
+func TestRenderMarkdown_IncludeNotes(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version: "1.3.0",
+				Date:    "2024-03-01",
+				Added: []changelog.Entry{
+					{Description: "OAuth login", Notes: "Refresh tokens are rotated\non every use."},
+					{Description: "Dark mode"},
+				},
+			},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, DefaultOptions())
+	if strings.Contains(md, "Refresh tokens") {
+		t.Errorf("did not expect notes by default, got:\n%s", md)
+	}
+
+	md = RenderMarkdownWithOptions(cl, DefaultOptions().WithIncludeNotes(true))
+	if !strings.Contains(md, "- OAuth login\n\n  Refresh tokens are rotated\n  on every use.\n- Dark mode\n") {
+		t.Errorf("expected indented notes paragraph, got:\n%s", md)
+	}
+}
+
+func TestRenderMarkdown_MaxReleases(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion:  "1.0",
+		Project:    "test",
+		Repository: "https://github.com/example/repo",
+		Unreleased: &changelog.Release{Added: []changelog.Entry{{Description: "WIP"}}},
+		Releases: []changelog.Release{
+			{Version: "1.3.0", Date: "2024-04-01", Added: []changelog.Entry{{Description: "Four"}}},
+			{Version: "1.2.0", Date: "2024-03-01", Added: []changelog.Entry{{Description: "Three"}}},
+			{Version: "1.1.0", Date: "2024-02-01", Added: []changelog.Entry{{Description: "Two"}}},
+			{Version: "1.0.0", Date: "2024-01-01", Added: []changelog.Entry{{Description: "One"}}},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, DefaultOptions().WithMaxReleases(2))
+
+	for _, want := range []string{
+		"## [Unreleased]",
+		"## [1.3.0]",
+		"## [1.2.0]",
+		"> Showing 2 of 4 releases. See [full changelog](https://github.com/example/repo).\n",
+		"[1.2.0]: https://github.com/example/repo/compare/1.1.0...1.2.0\n",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, md)
+		}
+	}
+	for _, unwanted := range []string{"## [1.1.0]", "## [1.0.0]", "[1.1.0]:", "[1.0.0]:"} {
+		if strings.Contains(md, unwanted) {
+			t.Errorf("did not expect %q, got:\n%s", unwanted, md)
+		}
+	}
+
+	// No footer when the limit is not reached
+	md = RenderMarkdownWithOptions(cl, DefaultOptions().WithMaxReleases(10))
+	if strings.Contains(md, "Showing") {
+		t.Errorf("did not expect truncation footer, got:\n%s", md)
+	}
+}
+
+func TestLimitReleases_CompactMaintenance(t *testing.T) {
+	releases := []changelog.Release{
+		{Version: "1.4.0", Added: []changelog.Entry{{Description: "Feature"}}},
+		{Version: "1.3.2", Dependencies: []changelog.Entry{{Description: "Deps"}}},
+		{Version: "1.3.1", Dependencies: []changelog.Entry{{Description: "Deps"}}},
+		{Version: "1.3.0", Added: []changelog.Entry{{Description: "Feature"}}},
+	}
+
+	if got := limitReleases(releases, 2, true); len(got) != 3 {
+		t.Errorf("expected maintenance group to count once (3 releases), got %d", len(got))
+	}
+	if got := limitReleases(releases, 2, false); len(got) != 2 {
+		t.Errorf("expected 2 releases without grouping, got %d", len(got))
+	}
+}
+
+func TestFilterNotableReleases(t *testing.T) {
+	releases := []changelog.Release{
+		{Version: "1.0.3", Added: []changelog.Entry{{Description: "Feature"}}},
+		{Version: "1.0.2", Dependencies: []changelog.Entry{{Description: "Deps"}}},
+		{Version: "1.0.1", Fixed: []changelog.Entry{{Description: "Fix"}}},
+		{Version: "1.0.0", Documentation: []changelog.Entry{{Description: "Docs"}}},
+	}
+
+	// Default policy
+	filtered := filterNotableReleases(releases, changelog.DefaultNotabilityPolicy())
+
+	if len(filtered) != 2 {
+		t.Errorf("expected 2 notable releases, got %d", len(filtered))
+	}
+
+	// Check correct releases are included
+	versions := make(map[string]bool)
+	for _, r := range filtered {
+		versions[r.Version] = true
+	}
+
+	if !versions["1.0.3"] {
+		t.Error("expected 1.0.3 to be included (has Added)")
+	}
+	if !versions["1.0.1"] {
+		t.Error("expected 1.0.1 to be included (has Fixed)")
+	}
+	if versions["1.0.2"] {
+		t.Error("expected 1.0.2 to be excluded (Dependencies only)")
+	}
+	if versions["1.0.0"] {
+		t.Error("expected 1.0.0 to be excluded (Documentation only)")
+	}
+}
+
+func TestFilterNotableReleases_NilPolicy(t *testing.T) {
+	releases := []changelog.Release{
+		{Version: "1.0.1", Dependencies: []changelog.Entry{{Description: "Deps"}}},
+		{Version: "1.0.0", Added: []changelog.Entry{{Description: "Feature"}}},
+	}
+
+	// Nil policy should use default
+	filtered := filterNotableReleases(releases, nil)
+
+	if len(filtered) != 1 {
+		t.Errorf("expected 1 notable release with nil policy, got %d", len(filtered))
+	}
+	if filtered[0].Version != "1.0.0" {
+		t.Errorf("expected version 1.0.0, got %s", filtered[0].Version)
+	}
+}
+
+func TestRenderMarkdown_ShowTierBadges(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version:     "1.0.0",
+				Date:        "2024-01-01",
+				Added:       []changelog.Entry{{Description: "Feature"}},
+				Performance: []changelog.Entry{{Description: "Faster"}},
+			},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, FullOptions())
+	if !strings.Contains(md, "### Added\n") || strings.Contains(md, "*(core)*") {
+		t.Errorf("expected no tier badges by default, got:\n%s", md)
+	}
+
+	md = RenderMarkdownWithOptions(cl, FullOptions().WithShowTierBadges(true))
+	if !strings.Contains(md, "### Added *(core)*\n") {
+		t.Errorf("expected core badge on Added, got:\n%s", md)
+	}
+	if !strings.Contains(md, "### Performance *(standard)*\n") {
+		t.Errorf("expected standard badge on Performance, got:\n%s", md)
+	}
+}
+
+func TestRenderMarkdown_EmojiHeaders(t *testing.T) {
+	e := []changelog.Entry{{Description: "Entry"}}
+	r := changelog.Release{
+		Version: "1.0.0", Date: "2024-01-01",
+		Highlights: e, Breaking: e, UpgradeGuide: e, Security: e,
+		Added: e, Changed: e, Deprecated: e, Removed: e, Fixed: e,
+		Performance: e, Dependencies: e, Documentation: e, Build: e, Tests: e,
+		Infrastructure: e, Observability: e, Compliance: e, Internal: e,
+		KnownIssues: e, Contributors: e,
+	}
+	names := changelog.DefaultRegistry.Names()
+	cl := &changelog.Changelog{IRVersion: "1.0", Project: "test", Releases: []changelog.Release{r}}
+
+	if len(names) != 20 || len(CategoryEmoji) != 20 {
+		t.Fatalf("expected 20 categories and emoji, got %d and %d", len(names), len(CategoryEmoji))
+	}
+
+	plain := RenderMarkdownWithOptions(cl, FullOptions())
+	md := RenderMarkdownWithOptions(cl, FullOptions().WithEmojiHeaders(true))
+	for _, name := range names {
+		emoji, ok := CategoryEmoji[name]
+		if !ok || emoji == "" {
+			t.Errorf("missing emoji for %s", name)
+			continue
+		}
+		if !strings.Contains(md, "### "+emoji+" "+name+"\n") {
+			t.Errorf("expected emoji header for %s, got:\n%s", name, md)
+		}
+		if !strings.Contains(plain, "### "+name+"\n") || strings.Contains(plain, emoji+" "+name) {
+			t.Errorf("expected plain header for %s by default", name)
+		}
+	}
+}
+
+func TestRenderMarkdown_VersionPrefix(t *testing.T) {
+	newChangelog := func(v1, v2 string) *changelog.Changelog {
+		return &changelog.Changelog{
+			IRVersion:  "1.0",
+			Project:    "test",
+			Repository: "https://github.com/example/repo",
+			Releases: []changelog.Release{
+				{Version: v2, Date: "2024-02-01", Added: []changelog.Entry{{Description: "Feature"}}},
+				{Version: v1, Date: "2024-01-01", Added: []changelog.Entry{{Description: "Initial"}}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		cl     *changelog.Changelog
+		prefix string
+		want   []string
+	}{
+		{
+			name:   "stored with v, no prefix",
+			cl:     newChangelog("v1.0.0", "v1.1.0"),
+			prefix: "",
+			want: []string{
+				"## [v1.1.0] - 2024-02-01\n",
+				"[v1.1.0]: https://github.com/example/repo/compare/v1.0.0...v1.1.0\n",
+				"[v1.0.0]: https://github.com/example/repo/releases/tag/v1.0.0\n",
+			},
+		},
+		{
+			name:   "stored without v, v prefix",
+			cl:     newChangelog("1.0.0", "1.1.0"),
+			prefix: "v",
+			want: []string{
+				"## [v1.1.0] - 2024-02-01\n",
+				"## [v1.0.0] - 2024-01-01\n",
+				"[unreleased]: https://github.com/example/repo/compare/v1.1.0...HEAD\n",
+				"[v1.1.0]: https://github.com/example/repo/compare/v1.0.0...v1.1.0\n",
+				"[v1.0.0]: https://github.com/example/repo/releases/tag/v1.0.0\n",
+			},
+		},
+		{
+			name:   "stored with v, v prefix",
+			cl:     newChangelog("v1.0.0", "v1.1.0"),
+			prefix: "v",
+			want:   []string{"## [v1.1.0] - 2024-02-01\n"},
+		},
+		{
+			name:   "stored with v, stripped",
+			cl:     newChangelog("v1.0.0", "v1.1.0"),
+			prefix: VersionPrefixStrip,
+			want: []string{
+				"## [1.1.0] - 2024-02-01\n",
+				"[1.1.0]: https://github.com/example/repo/compare/1.0.0...1.1.0\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := RenderMarkdownWithOptions(tt.cl, FullOptions().WithVersionPrefix(tt.prefix))
+			for _, want := range tt.want {
+				if !strings.Contains(md, want) {
+					t.Errorf("expected %q in output:\n%s", want, md)
+				}
+			}
+			if strings.Contains(md, "vv1") {
+				t.Errorf("expected no doubled prefix, got:\n%s", md)
+			}
+		})
+	}
+}
+
+func TestRenderMarkdown_IncludeHighlightsFirst(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version:    "2.0.0",
+				Date:       "2024-02-01",
+				Highlights: []changelog.Entry{{Description: "New plugin system"}},
+				Added:      []changelog.Entry{{Description: "Plugin API"}},
+			},
+			{Version: "1.0.0", Date: "2024-01-01", Added: []changelog.Entry{{Description: "Initial"}}},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, FullOptions())
+	if strings.Count(md, "New plugin system") != 1 || strings.Contains(md, "> **Highlights**") {
+		t.Errorf("expected highlights rendered once without callout by default, got:\n%s", md)
+	}
+
+	md = RenderMarkdownWithOptions(cl, FullOptions().WithIncludeHighlightsFirst(true))
+	want := "> **Highlights**\n>\n> - New plugin system\n\n## [2.0.0] - 2024-02-01\n\n### Highlights\n\n- New plugin system\n"
+	if !strings.Contains(md, want) {
+		t.Errorf("expected highlights callout before header and section within release, got:\n%s", md)
+	}
+	if strings.Count(md, "> **Highlights**") != 1 {
+		t.Errorf("expected one callout for the release with highlights, got:\n%s", md)
+	}
+
+	md = RenderMarkdownWithOptions(cl, FullOptions().WithIncludeHighlightsFirst(true).WithMaxTier(changelog.TierCore))
+	if strings.Contains(md, "New plugin system") {
+		t.Errorf("expected highlights excluded by MaxTier, got:\n%s", md)
+	}
+}
+
+func TestRenderMarkdown_CompactEmptyCategories(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{Version: "1.0.0", Date: "2024-01-01", Added: []changelog.Entry{{Description: "Feature"}}},
+		},
+	}
+
+	opts := DefaultOptions().WithMaxTier(changelog.TierCore)
+	md := RenderMarkdownWithOptions(cl, opts)
+	if strings.Contains(md, "### Fixed") {
+		t.Errorf("expected empty categories to be omitted by default, got:\n%s", md)
+	}
+
+	md = RenderMarkdownWithOptions(cl, opts.WithCompactEmptyCategories(true))
+	if !strings.Contains(md, "### Added\n\n- Feature\n") {
+		t.Errorf("expected Added entries, got:\n%s", md)
+	}
+	if !strings.Contains(md, "### Fixed\n\n*(none this release)*\n") {
+		t.Errorf("expected empty Fixed header, got:\n%s", md)
+	}
+	if strings.Contains(md, "### Performance") {
+		t.Errorf("expected categories above MaxTier to be omitted, got:\n%s", md)
+	}
+}
+
+func TestRenderMarkdown_SortEntriesBy(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version: "1.0.0",
+				Date:    "2024-01-01",
+				Changed: []changelog.Entry{
+					{Description: "charlie"},
+					{Description: "Bravo", Breaking: true},
+					{Description: "alpha"},
+					{Description: "delta", Breaking: true},
+				},
+			},
+		},
+	}
+	opts := MinimalOptions()
+
+	tests := []struct {
+		sort EntrySort
+		want string
+	}{
+		{EntrySortNone, "- charlie\n- Bravo\n- alpha\n- delta\n"},
+		{EntrySortAlpha, "- alpha\n- Bravo\n- charlie\n- delta\n"},
+		{EntrySortBreakingFirst, "- Bravo\n- delta\n- charlie\n- alpha\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.sort), func(t *testing.T) {
+			sorted, err := opts.WithSortEntriesBy(tt.sort)
+			if err != nil {
+				t.Fatal(err)
+			}
+			md := RenderMarkdownWithOptions(cl, sorted)
+			if !strings.Contains(md, tt.want) {
+				t.Errorf("expected entries in order:\n%s\ngot:\n%s", tt.want, md)
+			}
+		})
+	}
+
+	if got := cl.Releases[0].Changed[0].Description; got != "charlie" {
+		t.Errorf("expected source changelog to be unchanged, first entry is %q", got)
+	}
+}
+
+func TestRenderMarkdown_GroupByComponent(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version: "1.0.0",
+				Date:    "2024-01-01",
+				Added: []changelog.Entry{
+					{Description: "Shared config", Component: ""},
+					{Description: "Login command", Component: "cli"},
+					{Description: "Retry client", Component: "sdk"},
+					{Description: "Logout command", Component: "cli"},
+					{Description: "Cleanup"},
+				},
+			},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, MinimalOptions())
+	if strings.Contains(md, "**cli:**") {
+		t.Errorf("expected no component prefix by default, got:\n%s", md)
+	}
+
+	md = RenderMarkdownWithOptions(cl, MinimalOptions().WithGroupByComponent(true))
+	want := "- Shared config\n- Cleanup\n- **cli:** Login command\n- **cli:** Logout command\n- **sdk:** Retry client\n"
+	if !strings.Contains(md, want) {
+		t.Errorf("expected entries grouped by component:\n%s\ngot:\n%s", want, md)
+	}
+
+	var descriptions []string
+	for _, e := range cl.Releases[0].Added {
+		descriptions = append(descriptions, e.Description)
+	}
+	if got := strings.Join(descriptions, ", "); got != "Shared config, Login command, Retry client, Logout command, Cleanup" {
+		t.Errorf("expected source release to be unchanged, got %s", got)
+	}
+}
+
+func TestRenderMarkdown_CommitHashLength(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version: "1.0.0",
+				Date:    "2026-01-03",
+				Added:   []changelog.Entry{{Description: "Feature", Commit: "abc123def4567890abc123def4567890abc123de"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		n    int
+		want string
+	}{
+		{"unset", 0, "(abc123d)"},
+		{"default", DefaultCommitHashLength, "(abc123d)"},
+		{"custom", 10, "(abc123def4)"},
+		{"below minimum", 2, "(abc1)"},
+		{"above maximum", 64, "(abc123def4567890abc123def4567890abc123de)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			md := RenderMarkdownWithOptions(cl, DefaultOptions().WithCommitHashLength(tt.n))
+			if !strings.Contains(md, tt.want) {
+				t.Errorf("expected %s, got:\n%s", tt.want, md)
+			}
+		})
+	}
+
+	if FullOptions().CommitHashLength != 12 {
+		t.Errorf("expected FullOptions to use 12-character hashes, got %d", FullOptions().CommitHashLength)
+	}
+}
+
+func TestRenderMarkdown_IncludeContributorsSection(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion:  "1.0",
+		Project:    "test",
+		Repository: "https://github.com/example/repo",
+		Unreleased: &changelog.Release{Added: []changelog.Entry{{Description: "WIP", Author: "bob"}}},
+		Releases: []changelog.Release{
+			{Version: "1.1.0", Date: "2024-02-01", Fixed: []changelog.Entry{{Description: "Fix", Author: "@alice"}, {Description: "Fix 2", Author: "carol"}}},
+			{Version: "1.0.0", Date: "2024-01-01", Added: []changelog.Entry{{Description: "Feature", Author: "Alice"}, {Description: "Docs"}}},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, DefaultOptions())
+	if strings.Contains(md, "## Contributors") {
+		t.Errorf("expected no Contributors section by default, got:\n%s", md)
+	}
+
+	md = RenderMarkdownWithOptions(cl, DefaultOptions().WithIncludeContributorsSection(true))
+	want := "\n## Contributors\n\n" +
+		"- [@alice](https://github.com/alice) (2)\n" +
+		"- [@bob](https://github.com/bob) (1)\n" +
+		"- [@carol](https://github.com/carol) (1)\n"
+	if !strings.HasSuffix(md, want) {
+		t.Errorf("expected Contributors section at end, got:\n%s", md)
+	}
+	if strings.Index(md, "[1.0.0]: ") > strings.Index(md, "## Contributors") {
+		t.Errorf("expected Contributors section after reference links, got:\n%s", md)
+	}
+
+	cl.Repository = ""
+	md = RenderMarkdownWithOptions(cl, DefaultOptions().WithIncludeContributorsSection(true))
+	if !strings.Contains(md, "- @alice (2)\n") {
+		t.Errorf("expected unlinked contributor without repository, got:\n%s", md)
+	}
+}
+
+func TestRenderMarkdown_ExternalURL(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion:  "1.0",
+		Project:    "test",
+		Repository: "https://github.com/example/repo",
+		Releases: []changelog.Release{
+			{
+				Version: "1.0.0",
+				Date:    "2024-01-01",
+				Fixed: []changelog.Entry{
+					{Description: "Jira fix", Issue: "PROJ-123", PR: "45", ExternalURL: "https://example.atlassian.net/browse/PROJ-123"},
+					{Description: "Linear fix", PR: "#46", ExternalURL: "https://linear.app/acme/issue/ENG-46"},
+					{Description: "Notion fix", ExternalURL: "https://notion.so/acme/page"},
+					{Description: "Repo fix", Issue: "47"},
+				},
+			},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, DefaultOptions().WithNotableOnly(false))
+	for _, want := range []string{
+		"- Jira fix ([PROJ-123](https://example.atlassian.net/browse/PROJ-123), [#45](https://github.com/example/repo/pull/45))\n",
+		"- Linear fix ([#46](https://linear.app/acme/issue/ENG-46))\n",
+		"- Notion fix ([https://notion.so/acme/page](https://notion.so/acme/page))\n",
+		"- Repo fix ([#47](https://github.com/example/repo/issues/47))\n",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, md)
+		}
+	}
+}
+
+func TestRenderMarkdown_ShowVersionBump(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{Version: "2.0.0", Date: "2024-03-01", Changed: []changelog.Entry{{Description: "New API", Breaking: true}}},
+			{Version: "1.1.0", Date: "2024-02-01", Added: []changelog.Entry{{Description: "Feature"}}},
+			{Version: "1.0.1", Date: "2024-01-15", Fixed: []changelog.Entry{{Description: "Fix"}}},
+		},
+	}
+
+	opts := DefaultOptions().WithNotableOnly(false)
+	md := RenderMarkdownWithOptions(cl, opts)
+	if strings.Contains(md, "*(minor)*") {
+		t.Errorf("expected no version bump by default, got:\n%s", md)
+	}
+
+	md = RenderMarkdownWithOptions(cl, opts.WithShowVersionBump(true))
+	for _, want := range []string{
+		"## [2.0.0] - 2024-03-01 *(major)*\n",
+		"## [1.1.0] - 2024-02-01 *(minor)*\n",
+		"## [1.0.1] - 2024-01-15 *(patch)*\n",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, md)
+		}
 	}
 }
@@ -2,6 +2,8 @@ package renderer
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 
 	"github.com/grokify/structured-changelog/changelog"
 )
@@ -22,12 +24,118 @@ type Options struct {
 	// Authors listed in Changelog.Maintainers or known bots are excluded.
 	IncludeAuthors bool
 
+	// IncludeContributorsSection appends a "## Contributors" section after
+	// the reference links, listing each unique Entry.Author across all
+	// releases with a profile link and their number of entries.
+	IncludeContributorsSection bool
+
+	// IncludeAffects prefixes entries with the components they affect,
+	// e.g. "- (api, sdk) Description".
+	IncludeAffects bool
+
+	// IncludeDeprecationSince appends "(since vX.Y.Z)" to entries that have
+	// a Since version.
+	IncludeDeprecationSince bool
+
+	// IncludeRemovalDates appends "*(removal planned: v2.0.0)*" to entries
+	// that have a PlannedRemoval.
+	IncludeRemovalDates bool
+
+	// IncludeNotes renders an entry's Notes as an indented paragraph under
+	// its list item.
+	IncludeNotes bool
+
+	// EmojiHeaders prefixes category headers with the emoji from
+	// CategoryEmoji, e.g. "### 🚀 Added".
+	EmojiHeaders bool
+
+	// ShowTierBadges appends the category's tier to category headers, e.g.
+	// "### Added *(core)*".
+	ShowTierBadges bool
+
+	// CompactEmptyCategories renders a header for every category up to
+	// MaxTier, even if empty, so each release has the same structure. Empty
+	// categories are annotated with "*(none this release)*". By default only
+	// non-empty categories are rendered.
+	CompactEmptyCategories bool
+
+	// IncludeHighlightsFirst also renders each release's Highlights as a
+	// "> **Highlights**" blockquote before the release header. The
+	// Highlights section within the release is rendered as usual.
+	IncludeHighlightsFirst bool
+
+	// IncludeUpgradeGuide includes the Upgrade Guide category in the main
+	// changelog. Disable it when publishing RenderUpgradeGuide separately.
+	IncludeUpgradeGuide bool
+
+	// IncludeKnownIssues includes the Known Issues category. Unlike MaxTier
+	// filtering, this only affects Known Issues.
+	IncludeKnownIssues bool
+
+	// KnownIssuesStyle controls how Known Issues are rendered: "inline" as a
+	// regular "### Known Issues" section, or "callout" as a blockquote
+	// warning box. Empty uses "inline".
+	KnownIssuesStyle KnownIssuesStyle
+
+	// SortEntriesBy orders entries within each category: "none" keeps
+	// insertion order, "alpha" sorts by description, and "breaking-first"
+	// moves breaking entries first. Sorting is stable and does not modify
+	// the changelog. Empty uses "none".
+	SortEntriesBy EntrySort
+
+	// GroupByComponent groups entries by Component within each category and
+	// prefixes them with "**component:**". Entries without a component
+	// come first. The changelog is not modified.
+	GroupByComponent bool
+
 	// IncludeSecurityMetadata includes CVE/GHSA/severity in security entries.
 	IncludeSecurityMetadata bool
 
 	// MarkBreakingChanges prefixes breaking changes with **BREAKING:**.
 	MarkBreakingChanges bool
 
+	// CustomBreakingPrefix replaces the default **BREAKING:** marker when
+	// MarkBreakingChanges is true (e.g., "⚠️ BREAKING:" or "[BREAKING]").
+	// Empty uses the localized default.
+	CustomBreakingPrefix string
+
+	// EntryPrefix is the list marker written before each entry. Must be one
+	// of "- ", "* ", or "+ ". Empty uses "- ".
+	EntryPrefix string
+
+	// CommitHashLength is the number of characters shown for commit hashes,
+	// clamped to 4-40. Zero uses DefaultCommitHashLength.
+	CommitHashLength int
+
+	// WrapWidth hard-wraps entry lines at word boundaries so they fit within
+	// this many columns, including the list marker. Zero disables wrapping.
+	WrapWidth int
+
+	// IncludeDates appends " - YYYY-MM-DD" to release headers. Disable it for
+	// changelogs that must not disclose release dates.
+	IncludeDates bool
+
+	// ShowVersionBump appends the semver bump type implied by a release's
+	// entries to its header, e.g. "## [1.1.0] - 2024-01-15 *(minor)*":
+	// major if it has breaking changes, minor if it has Added entries, and
+	// patch otherwise.
+	ShowVersionBump bool
+
+	// DateFormat is the Go time layout used for release dates, e.g.
+	// "January 2, 2006" or "02/01/2006". Dates that do not parse as
+	// YYYY-MM-DD are rendered as-is. Empty uses "2006-01-02".
+	DateFormat string
+
+	// VersionPrefix adjusts versions in release headers and reference links:
+	// "v" renders a stored "1.0.0" as "v1.0.0", and "-" (VersionPrefixStrip)
+	// renders "v1.0.0" as "1.0.0". Empty renders versions as stored.
+	VersionPrefix string
+
+	// VersionLinkStyle controls how versions appear in release headers:
+	// "bracketed" (## [1.0.0]), "plain" (## 1.0.0), or "linked"
+	// (## [1.0.0](url) using the release's CompareURL). Empty uses "bracketed".
+	VersionLinkStyle VersionLinkStyle
+
 	// IncludeCompareLinks adds version comparison links at the bottom.
 	IncludeCompareLinks bool
 
@@ -40,6 +148,11 @@ type Options struct {
 	// into a single compact section like "## Versions 0.71.1 - 0.71.10 (Maintenance)".
 	CompactMaintenanceReleases bool
 
+	// MaxReleases limits output to the N most recent releases. A group of
+	// compacted maintenance releases counts as one. The Unreleased section
+	// is not counted. Zero means no limit.
+	MaxReleases int
+
 	// MaxTier filters change types to include only those at or above this tier.
 	// Default is TierOptional (include all).
 	MaxTier changelog.Tier
@@ -50,6 +163,7 @@ type Options struct {
 
 	// LocaleOverrides specifies a path to a JSON file with locale message overrides.
 	// Only the messages specified in this file will be replaced; others use defaults.
+	// The format is defined by schema/locale-overrides-v1.schema.json.
 	LocaleOverrides string
 
 	// NotableOnly when true, only includes releases that are considered "notable"
@@ -69,10 +183,20 @@ func DefaultOptions() Options {
 	return Options{
 		IncludeReferences:          true,
 		IncludeCommits:             true,
+		CommitHashLength:           DefaultCommitHashLength,
 		LinkReferences:             true,
 		IncludeAuthors:             true,
+		IncludeUpgradeGuide:        true,
+		IncludeKnownIssues:         true,
+		KnownIssuesStyle:           KnownIssuesStyleInline,
+		SortEntriesBy:              EntrySortNone,
 		IncludeSecurityMetadata:    true,
 		MarkBreakingChanges:        true,
+		CustomBreakingPrefix:       "", // use localized default
+		EntryPrefix:                "- ",
+		IncludeDates:               true,
+		DateFormat:                 DefaultDateFormat,
+		VersionLinkStyle:           VersionLinkStyleBracketed,
 		IncludeCompareLinks:        true,
 		IncludeUnreleasedLink:      true,
 		CompactMaintenanceReleases: true,
@@ -88,10 +212,19 @@ func MinimalOptions() Options {
 	return Options{
 		IncludeReferences:          false,
 		IncludeCommits:             false,
+		CommitHashLength:           DefaultCommitHashLength,
 		LinkReferences:             false,
 		IncludeAuthors:             false,
+		IncludeUpgradeGuide:        true,
+		IncludeKnownIssues:         true,
+		KnownIssuesStyle:           KnownIssuesStyleInline,
+		SortEntriesBy:              EntrySortNone,
 		IncludeSecurityMetadata:    false,
 		MarkBreakingChanges:        false,
+		EntryPrefix:                "- ",
+		IncludeDates:               true,
+		DateFormat:                 DefaultDateFormat,
+		VersionLinkStyle:           VersionLinkStyleBracketed,
 		IncludeCompareLinks:        false,
 		IncludeUnreleasedLink:      false,
 		CompactMaintenanceReleases: true,
@@ -109,10 +242,20 @@ func FullOptions() Options {
 	return Options{
 		IncludeReferences:          true,
 		IncludeCommits:             true,
+		CommitHashLength:           12, // match GitHub's longer short hash
 		LinkReferences:             true,
 		IncludeAuthors:             true,
+		IncludeUpgradeGuide:        true,
+		IncludeKnownIssues:         true,
+		KnownIssuesStyle:           KnownIssuesStyleInline,
+		SortEntriesBy:              EntrySortNone,
 		IncludeSecurityMetadata:    true,
 		MarkBreakingChanges:        true,
+		CustomBreakingPrefix:       "", // use localized default
+		EntryPrefix:                "- ",
+		IncludeDates:               true,
+		DateFormat:                 DefaultDateFormat,
+		VersionLinkStyle:           VersionLinkStyleBracketed,
 		IncludeCompareLinks:        true,
 		IncludeUnreleasedLink:      true,
 		CompactMaintenanceReleases: false, // Full detail shows all releases expanded
@@ -127,10 +270,19 @@ func CoreOptions() Options {
 	return Options{
 		IncludeReferences:          true,
 		IncludeCommits:             false,
+		CommitHashLength:           DefaultCommitHashLength,
 		LinkReferences:             false,
 		IncludeAuthors:             true,
+		IncludeUpgradeGuide:        true,
+		IncludeKnownIssues:         true,
+		KnownIssuesStyle:           KnownIssuesStyleInline,
+		SortEntriesBy:              EntrySortNone,
 		IncludeSecurityMetadata:    true,
 		MarkBreakingChanges:        true,
+		EntryPrefix:                "- ",
+		IncludeDates:               true,
+		DateFormat:                 DefaultDateFormat,
+		VersionLinkStyle:           VersionLinkStyleBracketed,
 		IncludeCompareLinks:        true,
 		IncludeUnreleasedLink:      true,
 		CompactMaintenanceReleases: true,
@@ -146,10 +298,19 @@ func StandardOptions() Options {
 	return Options{
 		IncludeReferences:          true,
 		IncludeCommits:             false,
+		CommitHashLength:           DefaultCommitHashLength,
 		LinkReferences:             false,
 		IncludeAuthors:             true,
+		IncludeUpgradeGuide:        true,
+		IncludeKnownIssues:         true,
+		KnownIssuesStyle:           KnownIssuesStyleInline,
+		SortEntriesBy:              EntrySortNone,
 		IncludeSecurityMetadata:    true,
 		MarkBreakingChanges:        true,
+		EntryPrefix:                "- ",
+		IncludeDates:               true,
+		DateFormat:                 DefaultDateFormat,
+		VersionLinkStyle:           VersionLinkStyleBracketed,
 		IncludeCompareLinks:        true,
 		IncludeUnreleasedLink:      true,
 		CompactMaintenanceReleases: true,
@@ -160,6 +321,107 @@ func StandardOptions() Options {
 	}
 }
 
+// WithEntryPrefix returns a copy of the options with the EntryPrefix field set.
+// Returns ErrInvalidEntryPrefix unless prefix is "- ", "* ", or "+ ".
+func (o Options) WithEntryPrefix(prefix string) (Options, error) {
+	if !slices.Contains(ValidEntryPrefixes, prefix) {
+		return o, fmt.Errorf("%w: %q", ErrInvalidEntryPrefix, prefix)
+	}
+	o.EntryPrefix = prefix
+	return o, nil
+}
+
+// WithWrapWidth returns a copy of the options with the WrapWidth field set.
+func (o Options) WithWrapWidth(width int) Options {
+	o.WrapWidth = width
+	return o
+}
+
+// WithIncludeDates returns a copy of the options with IncludeDates set.
+func (o Options) WithIncludeDates(enabled bool) Options {
+	o.IncludeDates = enabled
+	return o
+}
+
+// WithVersionPrefix returns a copy of the options with the VersionPrefix field set.
+func (o Options) WithVersionPrefix(prefix string) Options {
+	o.VersionPrefix = prefix
+	return o
+}
+
+// WithDateFormat returns a copy of the options with the DateFormat field set.
+func (o Options) WithDateFormat(layout string) Options {
+	o.DateFormat = layout
+	return o
+}
+
+// WithVersionLinkStyle returns a copy of the options with the VersionLinkStyle
+// field set. Returns ErrInvalidVersionLinkStyle for an unknown style.
+func (o Options) WithVersionLinkStyle(style VersionLinkStyle) (Options, error) {
+	if !slices.Contains(ValidVersionLinkStyles, style) {
+		return o, fmt.Errorf("%w: %q", ErrInvalidVersionLinkStyle, style)
+	}
+	o.VersionLinkStyle = style
+	return o, nil
+}
+
+// WithIncludeHighlightsFirst returns a copy of the options with IncludeHighlightsFirst set.
+func (o Options) WithIncludeHighlightsFirst(enabled bool) Options {
+	o.IncludeHighlightsFirst = enabled
+	return o
+}
+
+// WithIncludeUpgradeGuide returns a copy of the options with IncludeUpgradeGuide set.
+func (o Options) WithIncludeUpgradeGuide(enabled bool) Options {
+	o.IncludeUpgradeGuide = enabled
+	return o
+}
+
+// WithIncludeKnownIssues returns a copy of the options with IncludeKnownIssues set.
+func (o Options) WithIncludeKnownIssues(enabled bool) Options {
+	o.IncludeKnownIssues = enabled
+	return o
+}
+
+// WithKnownIssuesStyle returns a copy of the options with the KnownIssuesStyle
+// field set. Returns ErrInvalidKnownIssuesStyle for an unknown style.
+func (o Options) WithKnownIssuesStyle(style KnownIssuesStyle) (Options, error) {
+	if !slices.Contains(ValidKnownIssuesStyles, style) {
+		return o, fmt.Errorf("%w: %q", ErrInvalidKnownIssuesStyle, style)
+	}
+	o.KnownIssuesStyle = style
+	return o, nil
+}
+
+// WithSortEntriesBy returns a copy of the options with the SortEntriesBy
+// field set. Returns ErrInvalidEntrySort for an unknown sort order.
+func (o Options) WithSortEntriesBy(sort EntrySort) (Options, error) {
+	if !slices.Contains(ValidEntrySorts, sort) {
+		return o, fmt.Errorf("%w: %q", ErrInvalidEntrySort, sort)
+	}
+	o.SortEntriesBy = sort
+	return o, nil
+}
+
+// WithGroupByComponent returns a copy of the options with GroupByComponent set.
+func (o Options) WithGroupByComponent(enabled bool) Options {
+	o.GroupByComponent = enabled
+	return o
+}
+
+// WithCommitHashLength returns a copy of the options with the
+// CommitHashLength field set.
+func (o Options) WithCommitHashLength(n int) Options {
+	o.CommitHashLength = n
+	return o
+}
+
+// WithMaxReleases returns a copy of the options with the MaxReleases field set.
+func (o Options) WithMaxReleases(n int) Options {
+	o.MaxReleases = n
+	return o
+}
+
 // WithMaxTier returns a copy of the options with the MaxTier field set.
 func (o Options) WithMaxTier(tier changelog.Tier) Options {
 	o.MaxTier = tier
@@ -178,6 +440,69 @@ func (o Options) WithLocaleOverrides(path string) Options {
 	return o
 }
 
+// WithBreakingPrefix returns a copy of the options with CustomBreakingPrefix set.
+// An empty prefix restores the default **BREAKING:** marker.
+func (o Options) WithBreakingPrefix(prefix string) Options {
+	o.CustomBreakingPrefix = prefix
+	return o
+}
+
+// WithIncludeAffects returns a copy of the options with IncludeAffects set.
+func (o Options) WithIncludeAffects(enabled bool) Options {
+	o.IncludeAffects = enabled
+	return o
+}
+
+// WithIncludeDeprecationSince returns a copy of the options with IncludeDeprecationSince set.
+func (o Options) WithIncludeDeprecationSince(enabled bool) Options {
+	o.IncludeDeprecationSince = enabled
+	return o
+}
+
+// WithIncludeNotes returns a copy of the options with IncludeNotes set.
+func (o Options) WithIncludeNotes(enabled bool) Options {
+	o.IncludeNotes = enabled
+	return o
+}
+
+// WithEmojiHeaders returns a copy of the options with EmojiHeaders set.
+func (o Options) WithEmojiHeaders(enabled bool) Options {
+	o.EmojiHeaders = enabled
+	return o
+}
+
+// WithShowTierBadges returns a copy of the options with ShowTierBadges set.
+func (o Options) WithShowTierBadges(enabled bool) Options {
+	o.ShowTierBadges = enabled
+	return o
+}
+
+// WithShowVersionBump returns a copy of the options with ShowVersionBump set.
+func (o Options) WithShowVersionBump(enabled bool) Options {
+	o.ShowVersionBump = enabled
+	return o
+}
+
+// WithIncludeContributorsSection returns a copy of the options with
+// IncludeContributorsSection set.
+func (o Options) WithIncludeContributorsSection(enabled bool) Options {
+	o.IncludeContributorsSection = enabled
+	return o
+}
+
+// WithCompactEmptyCategories returns a copy of the options with
+// CompactEmptyCategories set.
+func (o Options) WithCompactEmptyCategories(enabled bool) Options {
+	o.CompactEmptyCategories = enabled
+	return o
+}
+
+// WithIncludeRemovalDates returns a copy of the options with IncludeRemovalDates set.
+func (o Options) WithIncludeRemovalDates(enabled bool) Options {
+	o.IncludeRemovalDates = enabled
+	return o
+}
+
 // WithNotableOnly returns a copy of the options with NotableOnly set.
 // When enabled, only releases with entries in notable categories are included.
 func (o Options) WithNotableOnly(enabled bool) Options {
@@ -213,6 +538,86 @@ func OptionsFromPreset(preset string) (Options, error) {
 // ErrInvalidPreset is returned when an invalid options preset name is provided.
 var ErrInvalidPreset = errors.New("invalid preset")
 
+// ErrInvalidEntryPrefix is returned when an unsupported list marker is provided.
+var ErrInvalidEntryPrefix = errors.New("invalid entry prefix")
+
+// ValidEntryPrefixes lists the supported Markdown list markers for EntryPrefix.
+var ValidEntryPrefixes = []string{"- ", "* ", "+ "}
+
+// VersionPrefixStrip is the VersionPrefix value that strips a leading "v"
+// from versions.
+const VersionPrefixStrip = changelog.VersionPrefixStrip
+
+// Commit hash display lengths.
+const (
+	// DefaultCommitHashLength is the standard git short hash length.
+	DefaultCommitHashLength = 7
+	// MinCommitHashLength is the shortest commit hash that is rendered.
+	MinCommitHashLength = 4
+	// MaxCommitHashLength is the length of a full SHA-1 commit hash.
+	MaxCommitHashLength = 40
+)
+
+// DefaultDateFormat is the ISO 8601 layout used for release dates.
+const DefaultDateFormat = "2006-01-02"
+
+// VersionLinkStyle controls how version numbers are formatted in release headers.
+type VersionLinkStyle string
+
+// Version link styles.
+const (
+	// VersionLinkStyleBracketed renders "## [1.0.0]", resolved by the
+	// reference links at the bottom when a repository is set.
+	VersionLinkStyleBracketed VersionLinkStyle = "bracketed"
+	// VersionLinkStylePlain renders "## 1.0.0" with no brackets or links.
+	VersionLinkStylePlain VersionLinkStyle = "plain"
+	// VersionLinkStyleLinked renders "## [1.0.0](url)" with an inline link
+	// to the release's CompareURL, which works without a repository.
+	VersionLinkStyleLinked VersionLinkStyle = "linked"
+)
+
+// ErrInvalidVersionLinkStyle is returned when an unknown version link style is provided.
+var ErrInvalidVersionLinkStyle = errors.New("invalid version link style")
+
+// ValidVersionLinkStyles lists the supported values for VersionLinkStyle.
+var ValidVersionLinkStyles = []VersionLinkStyle{VersionLinkStyleBracketed, VersionLinkStylePlain, VersionLinkStyleLinked}
+
+// KnownIssuesStyle controls how the Known Issues category is rendered.
+type KnownIssuesStyle string
+
+// Known issues styles.
+const (
+	// KnownIssuesStyleInline renders a regular "### Known Issues" section.
+	KnownIssuesStyleInline KnownIssuesStyle = "inline"
+	// KnownIssuesStyleCallout renders a blockquote warning box.
+	KnownIssuesStyleCallout KnownIssuesStyle = "callout"
+)
+
+// ErrInvalidKnownIssuesStyle is returned when an unknown known issues style is provided.
+var ErrInvalidKnownIssuesStyle = errors.New("invalid known issues style")
+
+// ValidKnownIssuesStyles lists the supported values for KnownIssuesStyle.
+var ValidKnownIssuesStyles = []KnownIssuesStyle{KnownIssuesStyleInline, KnownIssuesStyleCallout}
+
+// EntrySort controls the order of entries within a category.
+type EntrySort string
+
+// Entry sort orders.
+const (
+	// EntrySortNone keeps entries in insertion order.
+	EntrySortNone EntrySort = "none"
+	// EntrySortAlpha sorts entries alphabetically by description.
+	EntrySortAlpha EntrySort = "alpha"
+	// EntrySortBreakingFirst moves breaking entries before the others.
+	EntrySortBreakingFirst EntrySort = "breaking-first"
+)
+
+// ErrInvalidEntrySort is returned when an unknown entry sort order is provided.
+var ErrInvalidEntrySort = errors.New("invalid entry sort")
+
+// ValidEntrySorts lists the supported values for SortEntriesBy.
+var ValidEntrySorts = []EntrySort{EntrySortNone, EntrySortAlpha, EntrySortBreakingFirst}
+
 // Config holds configuration for rendering options.
 type Config struct {
 	Preset            string   // default, minimal, full, core, standard
@@ -225,7 +630,9 @@ type Config struct {
 
 // OptionsFromConfig creates Options from a Config struct.
 // It first applies the preset, then overrides MaxTier, Locale, LocaleOverrides,
-// and notability settings if specified.
+// and notability settings if specified. The LocaleOverrides file is loaded
+// and validated with LoadLocaleOverrides. When MaxTier is set without custom
+// NotableCategories, the notability policy is derived from the tier.
 func OptionsFromConfig(cfg Config) (Options, error) {
 	opts, err := OptionsFromPreset(cfg.Preset)
 	if err != nil {
@@ -245,6 +652,9 @@ func OptionsFromConfig(cfg Config) (Options, error) {
 	}
 
 	if cfg.LocaleOverrides != "" {
+		if _, err := LoadLocaleOverrides(cfg.LocaleOverrides); err != nil {
+			return Options{}, err
+		}
 		opts = opts.WithLocaleOverrides(cfg.LocaleOverrides)
 	}
 
@@ -255,6 +665,9 @@ func OptionsFromConfig(cfg Config) (Options, error) {
 	} else if len(cfg.NotableCategories) > 0 {
 		// Custom notable categories (only applies when not AllReleases)
 		opts = opts.WithNotabilityPolicy(changelog.NewNotabilityPolicy(cfg.NotableCategories))
+	} else if cfg.MaxTier != "" {
+		// Tier override: releases are notable if they have entries that will be rendered
+		opts = opts.WithNotabilityPolicy(changelog.NotabilityPolicyFromTier(opts.MaxTier))
 	}
 
 	return opts, nil
@@ -88,6 +88,67 @@ func TestWithMaxTier(t *testing.T) {
 	}
 }
 
+func TestWithBreakingPrefix(t *testing.T) {
+	opts := DefaultOptions()
+	if opts.CustomBreakingPrefix != "" {
+		t.Errorf("expected empty default CustomBreakingPrefix, got %q", opts.CustomBreakingPrefix)
+	}
+
+	custom := opts.WithBreakingPrefix("[BREAKING]")
+	if custom.CustomBreakingPrefix != "[BREAKING]" {
+		t.Errorf("expected CustomBreakingPrefix '[BREAKING]', got %q", custom.CustomBreakingPrefix)
+	}
+
+	// Original should be unchanged
+	if opts.CustomBreakingPrefix != "" {
+		t.Error("original options should not be modified")
+	}
+}
+
+func TestWithEntryPrefix(t *testing.T) {
+	opts := DefaultOptions()
+	if opts.EntryPrefix != "- " {
+		t.Errorf("expected default EntryPrefix '- ', got %q", opts.EntryPrefix)
+	}
+
+	for _, prefix := range ValidEntryPrefixes {
+		custom, err := opts.WithEntryPrefix(prefix)
+		if err != nil {
+			t.Errorf("WithEntryPrefix(%q) failed: %v", prefix, err)
+		}
+		if custom.EntryPrefix != prefix {
+			t.Errorf("expected EntryPrefix %q, got %q", prefix, custom.EntryPrefix)
+		}
+	}
+
+	for _, prefix := range []string{"", "-", "• ", "1. "} {
+		if _, err := opts.WithEntryPrefix(prefix); !errors.Is(err, ErrInvalidEntryPrefix) {
+			t.Errorf("WithEntryPrefix(%q) error = %v, expected ErrInvalidEntryPrefix", prefix, err)
+		}
+	}
+}
+
+func TestWithVersionLinkStyle(t *testing.T) {
+	opts := DefaultOptions()
+	if opts.VersionLinkStyle != VersionLinkStyleBracketed {
+		t.Errorf("expected default VersionLinkStyle bracketed, got %q", opts.VersionLinkStyle)
+	}
+
+	for _, style := range ValidVersionLinkStyles {
+		custom, err := opts.WithVersionLinkStyle(style)
+		if err != nil {
+			t.Errorf("WithVersionLinkStyle(%q) failed: %v", style, err)
+		}
+		if custom.VersionLinkStyle != style {
+			t.Errorf("expected VersionLinkStyle %q, got %q", style, custom.VersionLinkStyle)
+		}
+	}
+
+	if _, err := opts.WithVersionLinkStyle("inline"); !errors.Is(err, ErrInvalidVersionLinkStyle) {
+		t.Errorf("expected ErrInvalidVersionLinkStyle, got %v", err)
+	}
+}
+
 func TestOptionsFromPreset_Valid(t *testing.T) {
 	tests := []struct {
 		preset       string
@@ -153,6 +214,31 @@ func TestOptionsFromConfig_WithTierOverride(t *testing.T) {
 	}
 }
 
+func TestOptionsFromConfig_TierDerivedNotability(t *testing.T) {
+	opts, err := OptionsFromConfig(Config{Preset: "default", MaxTier: "core"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.NotabilityPolicy == nil {
+		t.Fatal("expected notability policy derived from tier")
+	}
+	if opts.NotabilityPolicy.IsNotable("Highlights") {
+		t.Error("expected Highlights to NOT be notable with core tier")
+	}
+	if !opts.NotabilityPolicy.IsNotable("Fixed") {
+		t.Error("expected Fixed to be notable with core tier")
+	}
+
+	// Custom notable categories take precedence over the tier
+	opts, err = OptionsFromConfig(Config{Preset: "default", MaxTier: "core", NotableCategories: []string{"Highlights"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !opts.NotabilityPolicy.IsNotable("Highlights") {
+		t.Error("expected custom NotableCategories to take precedence")
+	}
+}
+
 func TestOptionsFromConfig_InvalidPreset(t *testing.T) {
 	cfg := Config{
 		Preset: "invalid",
@@ -304,3 +390,63 @@ func TestOptionsFromConfig_FullPresetIncludesAllReleases(t *testing.T) {
 		t.Error("expected NotableOnly to be false for full preset")
 	}
 }
+
+func TestOptions_IncludeDatesByDefault(t *testing.T) {
+	presets := map[string]Options{
+		"default":  DefaultOptions(),
+		"minimal":  MinimalOptions(),
+		"full":     FullOptions(),
+		"core":     CoreOptions(),
+		"standard": StandardOptions(),
+	}
+	for name, opts := range presets {
+		if !opts.IncludeDates {
+			t.Errorf("expected IncludeDates to be true for %s preset", name)
+		}
+	}
+	if DefaultOptions().WithIncludeDates(false).IncludeDates {
+		t.Error("expected WithIncludeDates(false) to disable dates")
+	}
+}
+
+func TestWithSortEntriesBy(t *testing.T) {
+	opts := DefaultOptions()
+	if opts.SortEntriesBy != EntrySortNone {
+		t.Errorf("expected insertion order by default, got %q", opts.SortEntriesBy)
+	}
+
+	for _, sort := range ValidEntrySorts {
+		custom, err := opts.WithSortEntriesBy(sort)
+		if err != nil {
+			t.Errorf("WithSortEntriesBy(%q) failed: %v", sort, err)
+		}
+		if custom.SortEntriesBy != sort {
+			t.Errorf("expected SortEntriesBy %q, got %q", sort, custom.SortEntriesBy)
+		}
+	}
+
+	if _, err := opts.WithSortEntriesBy("random"); !errors.Is(err, ErrInvalidEntrySort) {
+		t.Errorf("expected ErrInvalidEntrySort, got %v", err)
+	}
+}
+
+func TestWithKnownIssuesStyle(t *testing.T) {
+	opts := DefaultOptions()
+	if !opts.IncludeKnownIssues || opts.KnownIssuesStyle != KnownIssuesStyleInline {
+		t.Errorf("expected known issues included inline by default, got %v %q", opts.IncludeKnownIssues, opts.KnownIssuesStyle)
+	}
+
+	for _, style := range ValidKnownIssuesStyles {
+		custom, err := opts.WithKnownIssuesStyle(style)
+		if err != nil {
+			t.Errorf("WithKnownIssuesStyle(%q) failed: %v", style, err)
+		}
+		if custom.KnownIssuesStyle != style {
+			t.Errorf("expected KnownIssuesStyle %q, got %q", style, custom.KnownIssuesStyle)
+		}
+	}
+
+	if _, err := opts.WithKnownIssuesStyle("banner"); !errors.Is(err, ErrInvalidKnownIssuesStyle) {
+		t.Errorf("expected ErrInvalidKnownIssuesStyle, got %v", err)
+	}
+}
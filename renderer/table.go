@@ -0,0 +1,77 @@
+package renderer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+// tableHighlightMaxLen is the maximum number of characters shown in the
+// Highlights column before truncation.
+const tableHighlightMaxLen = 60
+
+// RenderMarkdownTable renders a compact Markdown table summarizing releases,
+// one row per release: version, date, the first highlight, and counts of
+// breaking changes and security fixes. It is intended as a quick-reference
+// table prepended to a full changelog. Only notable releases are included
+// when opts.NotableOnly is set, and category column headers follow opts.Locale.
+func RenderMarkdownTable(cl *changelog.Changelog, opts Options) string {
+	l := getLocalizer(opts)
+
+	releases := cl.Releases
+	if opts.NotableOnly {
+		releases = filterNotableReleases(cl.Releases, opts.NotabilityPolicy)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "| Version | Date | %s | %s | %s |\n",
+		localizedCategoryName(l, changelog.CategoryHighlights),
+		localizedCategoryName(l, changelog.CategoryBreaking),
+		localizedCategoryName(l, changelog.CategorySecurity))
+	sb.WriteString("|---------|------|------------|----------|----------|\n")
+
+	for i := range releases {
+		r := &releases[i]
+
+		var highlight string
+		if len(r.Highlights) > 0 {
+			highlight = escapeTableCell(truncateRunes(r.Highlights[0].Description, tableHighlightMaxLen))
+		}
+
+		fmt.Fprintf(&sb, "| %s | %s | %s | %d | %d |\n",
+			escapeTableCell(r.Version), r.Date, highlight, countBreaking(r), len(r.Security))
+	}
+
+	return sb.String()
+}
+
+// countBreaking returns the number of entries in the Breaking category plus
+// entries flagged as breaking in other categories.
+func countBreaking(r *changelog.Release) int {
+	count := 0
+	for _, cat := range r.Categories() {
+		for _, e := range cat.Entries {
+			if cat.Name == changelog.CategoryBreaking || e.Breaking {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// truncateRunes shortens s to at most maxLen characters, replacing the
+// final character with an ellipsis when truncated.
+func truncateRunes(s string, maxLen int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+	return string(runes[:maxLen-1]) + "…"
+}
+
+// escapeTableCell escapes characters that would break a Markdown table cell.
+func escapeTableCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
@@ -0,0 +1,79 @@
+package renderer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+func TestRenderMarkdownTable(t *testing.T) {
+	longHighlight := strings.Repeat("a", 70)
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version:    "2.0.0",
+				Date:       "2026-03-01",
+				Highlights: []changelog.Entry{{Description: longHighlight}, {Description: "Second"}},
+				Breaking:   []changelog.Entry{{Description: "Removed v1 API"}},
+				Changed:    []changelog.Entry{{Description: "Renamed flag", Breaking: true}},
+				Security:   []changelog.Entry{{Description: "Fix XSS"}, {Description: "Fix CSRF"}},
+			},
+			{
+				Version:    "1.1.0",
+				Date:       "2026-02-01",
+				Highlights: []changelog.Entry{{Description: "Pipes | in text"}},
+				Added:      []changelog.Entry{{Description: "Feature"}},
+			},
+			{
+				Version:      "1.0.1",
+				Date:         "2026-01-15",
+				Dependencies: []changelog.Entry{{Description: "Bump deps"}},
+			},
+		},
+	}
+
+	out := RenderMarkdownTable(cl, DefaultOptions())
+
+	expected := []string{
+		"| Version | Date | Highlights | Breaking | Security |\n",
+		"| 2.0.0 | 2026-03-01 | " + strings.Repeat("a", 59) + "… | 2 | 2 |\n",
+		"| 1.1.0 | 2026-02-01 | Pipes \\| in text | 0 | 0 |\n",
+	}
+	for _, want := range expected {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	// Maintenance-only release excluded with NotableOnly
+	if strings.Contains(out, "1.0.1") {
+		t.Errorf("expected maintenance release to be excluded, got:\n%s", out)
+	}
+
+	out = RenderMarkdownTable(cl, FullOptions())
+	if !strings.Contains(out, "| 1.0.1 | 2026-01-15 |  | 0 | 0 |\n") {
+		t.Errorf("expected maintenance release row with FullOptions, got:\n%s", out)
+	}
+}
+
+func TestTruncateRunes(t *testing.T) {
+	tests := []struct {
+		input    string
+		maxLen   int
+		expected string
+	}{
+		{"short", 10, "short"},
+		{"exactly10!", 10, "exactly10!"},
+		{"this is too long", 10, "this is t…"},
+		{"日本語のテキストです", 5, "日本語の…"},
+	}
+
+	for _, tt := range tests {
+		if got := truncateRunes(tt.input, tt.maxLen); got != tt.expected {
+			t.Errorf("truncateRunes(%q, %d) = %q, expected %q", tt.input, tt.maxLen, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package renderer
+
+import (
+	"strings"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+// RenderUpgradeGuide collects the Upgrade Guide entries from every release
+// into a standalone "Migration Guide" document. Each release with upgrade
+// notes gets a "## v1.0.0 → v2.0.0" section comparing it to the previous
+// release, in reverse chronological order. Unreleased upgrade notes, if any,
+// come first. Entries are formatted using opts as in RenderMarkdownWithOptions.
+func RenderUpgradeGuide(cl *changelog.Changelog, opts Options) string {
+	l := getLocalizer(opts)
+	ctx := renderContext{
+		cl:   cl,
+		opts: opts,
+		repo: changelog.ParseRepoURL(cl.Repository),
+		l:    l,
+	}
+
+	var sb strings.Builder
+	sb.WriteString("# " + l.T("guide.migration_title") + "\n")
+
+	if cl.Unreleased != nil && len(cl.Unreleased.UpgradeGuide) > 0 {
+		header := l.T("section.unreleased")
+		if len(cl.Releases) > 0 {
+			header = cl.Releases[0].Version + " → " + header
+		}
+		renderUpgradeGuideSection(&sb, header, cl.Unreleased.UpgradeGuide, ctx)
+	}
+
+	for i := range cl.Releases {
+		r := &cl.Releases[i]
+		if len(r.UpgradeGuide) == 0 {
+			continue
+		}
+		header := r.Version
+		if i+1 < len(cl.Releases) {
+			header = cl.Releases[i+1].Version + " → " + r.Version
+		}
+		renderUpgradeGuideSection(&sb, header, r.UpgradeGuide, ctx)
+	}
+
+	return sb.String()
+}
+
+func renderUpgradeGuideSection(sb *strings.Builder, header string, entries []changelog.Entry, ctx renderContext) {
+	sb.WriteString("\n## " + header + "\n\n")
+	for _, e := range entries {
+		renderEntry(sb, &e, ctx, changelog.CategoryUpgradeGuide)
+	}
+}
@@ -0,0 +1,95 @@
+package renderer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/grokify/structured-changelog/changelog"
+)
+
+func TestRenderUpgradeGuide(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Unreleased: &changelog.Release{
+			UpgradeGuide: []changelog.Entry{{Description: "Drop Go 1.21 support"}},
+		},
+		Releases: []changelog.Release{
+			{
+				Version:      "v3.0.0",
+				Date:         "2024-03-01",
+				UpgradeGuide: []changelog.Entry{{Description: "Rename Client.Do to Client.Send"}},
+				Added:        []changelog.Entry{{Description: "New transport"}},
+			},
+			{
+				Version: "v2.1.0",
+				Date:    "2024-02-01",
+				Added:   []changelog.Entry{{Description: "Retries"}},
+			},
+			{
+				Version:      "v2.0.0",
+				Date:         "2024-01-15",
+				UpgradeGuide: []changelog.Entry{{Description: "Config moved to YAML"}},
+			},
+			{
+				Version: "v1.0.0",
+				Date:    "2024-01-01",
+				Added:   []changelog.Entry{{Description: "Initial"}},
+			},
+		},
+	}
+
+	out := RenderUpgradeGuide(cl, DefaultOptions())
+
+	expected := "# Migration Guide\n" +
+		"\n## v3.0.0 → Unreleased\n\n- Drop Go 1.21 support\n" +
+		"\n## v2.1.0 → v3.0.0\n\n- Rename Client.Do to Client.Send\n" +
+		"\n## v1.0.0 → v2.0.0\n\n- Config moved to YAML\n"
+	if out != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", out, expected)
+	}
+}
+
+func TestRenderUpgradeGuide_Localized(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{Version: "v2.0.0", Date: "2024-01-15", UpgradeGuide: []changelog.Entry{{Description: "Config moved to YAML"}}},
+			{Version: "v1.0.0", Date: "2024-01-01"},
+		},
+	}
+
+	out := RenderUpgradeGuide(cl, DefaultOptions().WithLocale("fr"))
+	if !strings.HasPrefix(out, "# Guide de migration\n") {
+		t.Errorf("expected localized title, got:\n%s", out)
+	}
+}
+
+func TestRenderMarkdown_IncludeUpgradeGuide(t *testing.T) {
+	cl := &changelog.Changelog{
+		IRVersion: "1.0",
+		Project:   "test",
+		Releases: []changelog.Release{
+			{
+				Version:      "v3.0.0",
+				Date:         "2024-03-01",
+				UpgradeGuide: []changelog.Entry{{Description: "Rename Client.Do to Client.Send"}},
+				Added:        []changelog.Entry{{Description: "New transport"}},
+			},
+		},
+	}
+
+	md := RenderMarkdownWithOptions(cl, DefaultOptions())
+	if !strings.Contains(md, "### Upgrade Guide") {
+		t.Error("expected Upgrade Guide section by default")
+	}
+
+	md = RenderMarkdownWithOptions(cl, DefaultOptions().WithIncludeUpgradeGuide(false))
+	if strings.Contains(md, "### Upgrade Guide") || strings.Contains(md, "Client.Send") {
+		t.Errorf("expected Upgrade Guide to be suppressed, got:\n%s", md)
+	}
+	if !strings.Contains(md, "- New transport") {
+		t.Error("expected other categories to remain")
+	}
+}